@@ -0,0 +1,110 @@
+// Package aivalidator provides pluggable AI transaction-scoring backends
+// for the PoAI chain, replacing the fixed len(tx) heuristic that used to
+// live directly in CheckTx.
+package aivalidator
+
+import (
+	"context"
+	"fmt"
+)
+
+// Backend scores a transaction against a named AI model, returning both
+// the score and the feature vector that produced it so every validator
+// running the same backend can agree on what was seen.
+type Backend interface {
+	Score(ctx context.Context, modelID string, tx []byte) (score float64, features map[string]float64, err error)
+}
+
+// NoopBackend is a deterministic, dependency-free backend for tests and
+// local devnets. It reproduces the length-based heuristic CheckTx used
+// before pluggable backends existed.
+type NoopBackend struct{}
+
+// Score implements Backend.
+func (NoopBackend) Score(_ context.Context, _ string, tx []byte) (float64, map[string]float64, error) {
+	features := map[string]float64{"tx_len": float64(len(tx))}
+	if len(tx) > 0 && len(tx) < 1000 {
+		return 1.0, features, nil
+	}
+	return 0.0, features, nil
+}
+
+// Epsilon is the maximum disagreement allowed between the backends
+// registered for the same model type before a transaction is rejected as
+// non-deterministic.
+const Epsilon = 0.05
+
+// Result is the deterministic log line every validator running the same
+// registered backends should arrive at.
+type Result struct {
+	ModelID  string             `json:"model_id"`
+	Score    float64            `json:"score"`
+	Features map[string]float64 `json:"features"`
+}
+
+// Registry dispatches to the backend(s) registered for an AI model's
+// Type, and enforces that every backend registered for a type agrees
+// within Epsilon so all validators reach the same verdict.
+type Registry struct {
+	backends map[string][]Backend
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{backends: make(map[string][]Backend)}
+}
+
+// Register adds backend as one of (possibly several) backends answering
+// for modelType.
+func (r *Registry) Register(modelType string, backend Backend) {
+	r.backends[modelType] = append(r.backends[modelType], backend)
+}
+
+// Score runs every backend registered for modelType against tx and
+// returns their consensus score, erroring if any two disagree by more
+// than Epsilon or if modelType has no registered backend.
+func (r *Registry) Score(ctx context.Context, modelType, modelID string, tx []byte) (Result, error) {
+	backends := r.backends[modelType]
+	if len(backends) == 0 {
+		return Result{}, fmt.Errorf("aivalidator: no backend registered for model type %q", modelType)
+	}
+
+	scores := make([]float64, len(backends))
+	merged := make(map[string]float64)
+	for i, b := range backends {
+		score, features, err := b.Score(ctx, modelID, tx)
+		if err != nil {
+			return Result{}, fmt.Errorf("aivalidator: backend %d for %q failed: %w", i, modelType, err)
+		}
+		scores[i] = score
+		for k, v := range features {
+			merged[k] = v
+		}
+	}
+
+	lo, hi := scores[0], scores[0]
+	for _, s := range scores[1:] {
+		if s < lo {
+			lo = s
+		}
+		if s > hi {
+			hi = s
+		}
+	}
+	if hi-lo > Epsilon {
+		return Result{}, fmt.Errorf("aivalidator: backends for %q disagree beyond epsilon (%.4f vs %.4f)", modelType, lo, hi)
+	}
+
+	return Result{ModelID: modelID, Score: average(scores), Features: merged}, nil
+}
+
+func average(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
@@ -0,0 +1,105 @@
+package aivalidator
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// PredictRequest mirrors poai.v1.PredictRequest from
+// proto/poai/v1/inference.proto.
+type PredictRequest struct {
+	ModelID  string
+	Features map[string]float64
+}
+
+// PredictResponse mirrors poai.v1.PredictResponse from
+// proto/poai/v1/inference.proto.
+type PredictResponse struct {
+	Score float64
+}
+
+// InferenceServiceClient is satisfied by the generated poai.v1.Inference
+// client; it is declared here rather than imported so this package
+// compiles without a protoc toolchain, and an adapter over the generated
+// client is dropped in once it exists.
+type InferenceServiceClient interface {
+	Predict(ctx context.Context, req *PredictRequest) (*PredictResponse, error)
+}
+
+// GRPCBackend delegates scoring to an external AI inference service over
+// mTLS gRPC, as defined by proto/poai/v1/inference.proto.
+type GRPCBackend struct {
+	client InferenceServiceClient
+	conn   *grpc.ClientConn
+}
+
+// TLSConfig holds the mTLS material required to dial the inference
+// service: this node's client certificate/key and the CA that signed the
+// service's server certificate.
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+// NewGRPCBackend dials addr over mTLS using tlsCfg and wraps the
+// resulting connection's client via newClient, which callers supply as
+// the generated poai.v1.NewInferenceClient adapter.
+func NewGRPCBackend(addr string, tlsCfg TLSConfig, newClient func(*grpc.ClientConn) InferenceServiceClient) (*GRPCBackend, error) {
+	creds, err := loadTransportCredentials(tlsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("aivalidator: grpc tls setup: %w", err)
+	}
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("aivalidator: dial inference service %q: %w", addr, err)
+	}
+
+	return &GRPCBackend{client: newClient(conn), conn: conn}, nil
+}
+
+// Close tears down the underlying gRPC connection.
+func (b *GRPCBackend) Close() error {
+	return b.conn.Close()
+}
+
+// Score implements Backend by delegating to the external inference
+// service, passing tx length as the only feature until a richer feature
+// extractor is wired in.
+func (b *GRPCBackend) Score(ctx context.Context, modelID string, tx []byte) (float64, map[string]float64, error) {
+	features := map[string]float64{"tx_len": float64(len(tx))}
+	resp, err := b.client.Predict(ctx, &PredictRequest{ModelID: modelID, Features: features})
+	if err != nil {
+		return 0, nil, fmt.Errorf("aivalidator: grpc predict for %q: %w", modelID, err)
+	}
+	return resp.Score, features, nil
+}
+
+func loadTransportCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load client keypair: %w", err)
+	}
+
+	caBytes, err := os.ReadFile(cfg.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read ca file: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caBytes) {
+		return nil, fmt.Errorf("parse ca file %q", cfg.CAFile)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
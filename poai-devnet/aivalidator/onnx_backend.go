@@ -0,0 +1,106 @@
+package aivalidator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ort "github.com/yalue/onnxruntime_go"
+)
+
+// tokenWidth is the fixed-size feature vector every ONNX model in this
+// backend expects as input, produced by hashing tx bytes into buckets.
+const tokenWidth = 256
+
+// ONNXBackend scores transactions using a locally-loaded ONNX model per
+// modelID, via github.com/yalue/onnxruntime_go.
+type ONNXBackend struct {
+	modelDir string
+
+	mu       sync.Mutex
+	sessions map[string]*ort.AdvancedSession
+	inputs   map[string][]float32
+	outputs  map[string][]float32
+}
+
+// NewONNXBackend returns a backend that loads "<modelDir>/<modelID>.onnx"
+// on first use and keeps the session around for subsequent scoring calls.
+func NewONNXBackend(modelDir string) *ONNXBackend {
+	return &ONNXBackend{
+		modelDir: modelDir,
+		sessions: make(map[string]*ort.AdvancedSession),
+		inputs:   make(map[string][]float32),
+		outputs:  make(map[string][]float32),
+	}
+}
+
+// LoadModel eagerly loads and initializes the ONNX session for modelID,
+// returning an error if the model file is missing or malformed. Score
+// also lazily loads on first use, so calling LoadModel ahead of time is
+// only useful to surface a load failure before serving traffic.
+func (b *ONNXBackend) LoadModel(modelID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.loadLocked(modelID)
+}
+
+func (b *ONNXBackend) loadLocked(modelID string) error {
+	if _, ok := b.sessions[modelID]; ok {
+		return nil
+	}
+
+	input := make([]float32, tokenWidth)
+	output := make([]float32, 1)
+	inputTensor, err := ort.NewTensor(ort.NewShape(1, tokenWidth), input)
+	if err != nil {
+		return fmt.Errorf("aivalidator: onnx input tensor for %q: %w", modelID, err)
+	}
+	outputTensor, err := ort.NewTensor(ort.NewShape(1, 1), output)
+	if err != nil {
+		return fmt.Errorf("aivalidator: onnx output tensor for %q: %w", modelID, err)
+	}
+
+	path := fmt.Sprintf("%s/%s.onnx", b.modelDir, modelID)
+	session, err := ort.NewAdvancedSession(path,
+		[]string{"input"}, []string{"score"},
+		[]ort.ArbitraryTensor{inputTensor}, []ort.ArbitraryTensor{outputTensor}, nil)
+	if err != nil {
+		return fmt.Errorf("aivalidator: load onnx model %q: %w", modelID, err)
+	}
+
+	b.sessions[modelID] = session
+	b.inputs[modelID] = input
+	b.outputs[modelID] = output
+	return nil
+}
+
+// Score implements Backend by tokenizing tx into a fixed-width feature
+// vector and running the model's session against it.
+func (b *ONNXBackend) Score(_ context.Context, modelID string, tx []byte) (float64, map[string]float64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.loadLocked(modelID); err != nil {
+		return 0, nil, err
+	}
+
+	tokenize(tx, b.inputs[modelID])
+	if err := b.sessions[modelID].Run(); err != nil {
+		return 0, nil, fmt.Errorf("aivalidator: onnx inference for %q: %w", modelID, err)
+	}
+
+	score := float64(b.outputs[modelID][0])
+	features := map[string]float64{"tx_len": float64(len(tx))}
+	return score, features, nil
+}
+
+// tokenize buckets tx bytes into a fixed-width float32 vector so models
+// of any tx length get a uniform-shaped input tensor.
+func tokenize(tx []byte, out []float32) {
+	for i := range out {
+		out[i] = 0
+	}
+	for i, b := range tx {
+		out[i%len(out)] += float32(b) / 255.0
+	}
+}
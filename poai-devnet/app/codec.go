@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/aimodel"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poaiwitness"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/quantum"
+)
+
+// txEnvelope mirrors the Cosmos SDK's Amino-style type-tagged message
+// encoding: the concrete Msg type is named explicitly so it can be decoded
+// without a reflection-based proto registry.
+type txEnvelope struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// decodeMsg attempts to decode tx as a typed module Msg. Transactions that
+// aren't a txEnvelope (e.g. legacy free-form payloads) return an error so
+// callers can fall back to the pre-module validation path.
+func decodeMsg(tx []byte) (types.Msg, error) {
+	var env txEnvelope
+	if err := json.Unmarshal(tx, &env); err != nil {
+		return nil, err
+	}
+	if env.Type == "" {
+		return nil, fmt.Errorf("tx is not a typed message envelope")
+	}
+
+	switch env.Type {
+	case "aimodel/RegisterAIModel":
+		var msg aimodel.MsgRegisterAIModel
+		if err := json.Unmarshal(env.Value, &msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	case "aimodel/SubmitInference":
+		var msg aimodel.MsgSubmitInference
+		if err := json.Unmarshal(env.Value, &msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	case "quantum/CommitQuantumCircuit":
+		var msg quantum.MsgCommitQuantumCircuit
+		if err := json.Unmarshal(env.Value, &msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	case "poai/UpdateValidatorAIScore":
+		var msg poai.MsgUpdateValidatorAIScore
+		if err := json.Unmarshal(env.Value, &msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	case "poaiwitness/RequestWitness":
+		var msg poaiwitness.MsgRequestWitness
+		if err := json.Unmarshal(env.Value, &msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	default:
+		return nil, fmt.Errorf("unrecognized message type %q", env.Type)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/hex"
+	"math"
+	"sort"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+)
+
+// epochLength is how often, in blocks, validator power is recomputed
+// from each validator's AIScore. Shorter epochs react to AI agreement
+// faster; longer epochs give validator sets more stability.
+const epochLength int64 = 100
+
+// minPowerFactor and maxPowerFactor bound how far AIScore can move a
+// validator's power away from its BasePower in either direction, so a
+// single bad epoch can't zero out or runaway-amplify a validator.
+const (
+	minPowerFactor = 0.5
+	maxPowerFactor = 1.5
+)
+
+// scoreEMAWeight is the weight given to a validator's prior AIScore when
+// blending in the current epoch's agreement ratio: score_t = w*score_t-1
+// + (1-w)*agreement.
+const scoreEMAWeight = 0.9
+
+// BeginBlock runs slashing hooks for any validator CometBFT reports as
+// Byzantine, halving their AI score so EndBlock's next epoch update
+// rescales their power down until repeated good behavior brings the
+// score back up.
+func (app *PoAIApplication) BeginBlock(req abcitypes.RequestBeginBlock) abcitypes.ResponseBeginBlock {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	for _, evidence := range req.ByzantineValidators {
+		addr := crypto.Address(evidence.Validator.Address).String()
+		v, ok := app.state.Validators[addr]
+		if !ok {
+			continue
+		}
+
+		app.logger.Error("Slashing Byzantine validator", "address", addr, "type", evidence.Type)
+		v.AIScore /= 2
+		v.Power = int64(math.Floor(float64(v.BasePower) * clampPowerFactor(v.AIScore)))
+		if err := app.poaiKeeper.SetValidator(*v); err != nil {
+			app.logger.Error("Failed to persist slashed validator", "address", addr, "err", err)
+			continue
+		}
+		app.indexValidator(v)
+	}
+	return abcitypes.ResponseBeginBlock{}
+}
+
+// EndBlock recomputes validator power from AI agreement once per epoch.
+// Each validator's AIScore is updated as an exponential moving average
+// against the epoch's observed AI/quantum agreement ratio, then power is
+// rescaled from BasePower by that score, clamped to
+// [minPowerFactor, maxPowerFactor]. Validators are visited in address
+// order so every node in the network computes the same ValidatorUpdates.
+func (app *PoAIApplication) EndBlock(req abcitypes.RequestEndBlock) abcitypes.ResponseEndBlock {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	if req.Height%epochLength != 0 {
+		return abcitypes.ResponseEndBlock{}
+	}
+
+	agreement := 1.0
+	if app.state.EpochTxTotal > 0 {
+		agreement = float64(app.state.EpochTxPassed) / float64(app.state.EpochTxTotal)
+	}
+
+	addresses := make([]string, 0, len(app.state.Validators))
+	for addr := range app.state.Validators {
+		addresses = append(addresses, addr)
+	}
+	sort.Strings(addresses)
+
+	var updates []abcitypes.ValidatorUpdate
+	for _, addr := range addresses {
+		v := app.state.Validators[addr]
+
+		v.AIScore = scoreEMAWeight*v.AIScore + (1-scoreEMAWeight)*agreement
+		v.Power = int64(math.Floor(float64(v.BasePower) * clampPowerFactor(v.AIScore)))
+
+		if err := app.poaiKeeper.SetValidator(*v); err != nil {
+			app.logger.Error("Failed to persist epoch validator update", "address", addr, "err", err)
+			continue
+		}
+		app.indexValidator(v)
+
+		update, err := validatorUpdate(v)
+		if err != nil {
+			app.logger.Error("Failed to build validator update", "address", addr, "err", err)
+			continue
+		}
+		updates = append(updates, update)
+	}
+
+	app.state.EpochTxTotal = 0
+	app.state.EpochTxPassed = 0
+
+	app.logger.Info("Applied epoch validator power update", "height", req.Height, "agreement", agreement, "validators", len(updates))
+
+	return abcitypes.ResponseEndBlock{ValidatorUpdates: updates}
+}
+
+// clampPowerFactor bounds an AIScore to the range this epoch's power
+// rescaling is allowed to move a validator's power by.
+func clampPowerFactor(score float64) float64 {
+	if score < minPowerFactor {
+		return minPowerFactor
+	}
+	if score > maxPowerFactor {
+		return maxPowerFactor
+	}
+	return score
+}
+
+// validatorUpdate converts a poai.Validator's hex-encoded Ed25519 pubkey
+// back into the ABCI ValidatorUpdate CometBFT expects at the end of a
+// block.
+func validatorUpdate(v *poai.Validator) (abcitypes.ValidatorUpdate, error) {
+	pubKeyBytes, err := hex.DecodeString(v.PubKey)
+	if err != nil {
+		return abcitypes.ValidatorUpdate{}, err
+	}
+	pubKey, err := cryptoenc.PubKeyToProto(ed25519.PubKey(pubKeyBytes))
+	if err != nil {
+		return abcitypes.ValidatorUpdate{}, err
+	}
+	return abcitypes.ValidatorUpdate{PubKey: pubKey, Power: v.Power}, nil
+}
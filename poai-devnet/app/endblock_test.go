@@ -0,0 +1,102 @@
+package main
+
+import (
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+)
+
+func TestClampPowerFactor(t *testing.T) {
+	cases := []struct {
+		score float64
+		want  float64
+	}{
+		{score: 0, want: minPowerFactor},
+		{score: minPowerFactor, want: minPowerFactor},
+		{score: 1, want: 1},
+		{score: maxPowerFactor, want: maxPowerFactor},
+		{score: 10, want: maxPowerFactor},
+	}
+	for _, c := range cases {
+		if got := clampPowerFactor(c.score); got != c.want {
+			t.Errorf("clampPowerFactor(%v) = %v, want %v", c.score, got, c.want)
+		}
+	}
+}
+
+func TestEndBlockSkipsNonEpochHeights(t *testing.T) {
+	app := NewPoAIApplication(t.TempDir(), 0, "", "", "")
+	v := &poai.Validator{Address: "validator-1", Power: 10, BasePower: 10, AIScore: 1}
+	app.state.Validators[v.Address] = v
+	app.indexValidator(v)
+
+	resp := app.EndBlock(abcitypes.RequestEndBlock{Height: epochLength - 1})
+	if len(resp.ValidatorUpdates) != 0 {
+		t.Errorf("EndBlock at a non-epoch height returned %d updates, want 0", len(resp.ValidatorUpdates))
+	}
+	if v.AIScore != 1 {
+		t.Errorf("AIScore changed at a non-epoch height: got %v, want 1", v.AIScore)
+	}
+}
+
+func TestEndBlockAppliesEMAAndClampsPower(t *testing.T) {
+	app := NewPoAIApplication(t.TempDir(), 0, "", "", "")
+	v := &poai.Validator{Address: "validator-1", PubKey: "", Power: 10, BasePower: 10, AIScore: 1}
+	app.state.Validators[v.Address] = v
+	app.indexValidator(v)
+	if err := app.poaiKeeper.SetValidator(*v); err != nil {
+		t.Fatalf("SetValidator: %v", err)
+	}
+
+	// Every transaction this epoch failed, so agreement is 0 and the EMA
+	// should pull AIScore down from 1 toward scoreEMAWeight*1 + (1-scoreEMAWeight)*0.
+	app.state.EpochTxTotal = 10
+	app.state.EpochTxPassed = 0
+
+	app.EndBlock(abcitypes.RequestEndBlock{Height: epochLength})
+
+	wantScore := scoreEMAWeight * 1.0
+	if v.AIScore != wantScore {
+		t.Errorf("AIScore after epoch = %v, want %v", v.AIScore, wantScore)
+	}
+
+	wantPower := int64(float64(v.BasePower) * clampPowerFactor(wantScore))
+	if v.Power != wantPower {
+		t.Errorf("Power after epoch = %v, want %v", v.Power, wantPower)
+	}
+
+	if app.state.EpochTxTotal != 0 || app.state.EpochTxPassed != 0 {
+		t.Error("EndBlock did not reset the epoch tx counters")
+	}
+
+	stored, ok := app.poaiKeeper.GetValidator(v.Address)
+	if !ok {
+		t.Fatal("validator not persisted to the poai Keeper after EndBlock")
+	}
+	if stored.AIScore != wantScore {
+		t.Errorf("persisted AIScore = %v, want %v", stored.AIScore, wantScore)
+	}
+}
+
+func TestBeginBlockHalvesByzantineValidatorScore(t *testing.T) {
+	app := NewPoAIApplication(t.TempDir(), 0, "", "", "")
+
+	rawAddr := []byte{0xAB, 0xCD, 0xEF}
+	addr := crypto.Address(rawAddr).String()
+	v := &poai.Validator{Address: addr, Power: 10, BasePower: 10, AIScore: 1}
+	app.state.Validators[v.Address] = v
+	app.indexValidator(v)
+
+	app.BeginBlock(abcitypes.RequestBeginBlock{
+		ByzantineValidators: []abcitypes.Misbehavior{
+			{Validator: abcitypes.Validator{Address: rawAddr}},
+		},
+	})
+
+	if v.AIScore != 0.5 {
+		t.Errorf("AIScore after slashing = %v, want 0.5", v.AIScore)
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/gql"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/aimodel"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/quantum"
+)
+
+// The methods below satisfy gql.DataSource, letting the GraphQL server
+// read straight from the same state the ABCI methods use without the gql
+// package importing package main.
+
+func (app *PoAIApplication) GetStatus() gql.Status {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	return gql.Status{
+		Height:  app.state.Height,
+		AppHash: fmt.Sprintf("%x", app.state.AppHash),
+	}
+}
+
+func (app *PoAIApplication) GetAIModel(id string) (aimodel.Model, bool) {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	model, ok := app.state.AIModels[id]
+	if !ok {
+		return aimodel.Model{}, false
+	}
+	return *model, true
+}
+
+func (app *PoAIApplication) ListAIModels(modelType string) []aimodel.Model {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	models := make([]aimodel.Model, 0, len(app.state.AIModels))
+	for _, m := range app.state.AIModels {
+		if modelType != "" && m.Type != modelType {
+			continue
+		}
+		models = append(models, *m)
+	}
+	return models
+}
+
+func (app *PoAIApplication) GetValidator(address string) (poai.Validator, bool) {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	v, ok := app.state.Validators[address]
+	if !ok {
+		return poai.Validator{}, false
+	}
+	return *v, true
+}
+
+func (app *PoAIApplication) GetCreator() gql.Creator {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	c := app.state.Creator
+	return gql.Creator{
+		Name:    c.Name,
+		UID:     c.UID,
+		ORCID:   c.ORCID,
+		License: c.License,
+		Created: c.Created,
+	}
+}
+
+func (app *PoAIApplication) GetQuantumState() (quantum.State, bool) {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	if app.state.Quantum == nil {
+		return quantum.State{}, false
+	}
+	return *app.state.Quantum, true
+}
+
+func (app *PoAIApplication) GetAccountBalance(address string) (int64, bool) {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	balance, ok := app.state.Balances[address]
+	return balance, ok
+}
@@ -2,62 +2,97 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
-	"path/filepath"
+	"sync"
 	"syscall"
+	"time"
 
-	"github.com/cometbft/cometbft/libs/log"
-	cmtnode "github.com/cometbft/cometbft/node"
-	"github.com/cometbft/cometbft/p2p"
-	"github.com/cometbft/cometbft/privval"
-	"github.com/cometbft/cometbft/proxy"
-	cmtcfg "github.com/cometbft/cometbft/config"
 	abciclient "github.com/cometbft/cometbft/abci/client"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
-	"encoding/json"
-	"strconv"
-	"time"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmtmerkle "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/aivalidator"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/gql"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/store"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/aimodel"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poaiwitness"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/quantum"
 )
 
-// PoAIApplication implements the ABCI interface for Proof-of-AI consensus
+// defaultAIScoreThreshold is the minimum aivalidator score a transaction
+// must clear in CheckTx/DeliverTx's legacy validation path.
+const defaultAIScoreThreshold = 0.5
+
+// defaultAIOraclePubKeyHex is the out-of-the-box authority public key for
+// MsgUpdateValidatorAIScore: empty, so every update is rejected until an
+// operator explicitly configures a real oracle key with
+// --ai-oracle-pubkey. There is no safe default key to ship here, since
+// anyone who knew it could forge AI score updates.
+const defaultAIOraclePubKeyHex = ""
+
+// PoAIApplication implements the ABCI interface for Proof-of-AI consensus.
+// The x/poai, x/aimodel, and x/quantum Keepers are the authoritative,
+// provable store for their records (see store.SparseMerkleTree); the maps
+// on ApplicationState remain as a fast in-memory cache for the legacy
+// Info/Query paths and for EndBlock's per-epoch validator math.
 type PoAIApplication struct {
-	logger log.Logger
-	state  *ApplicationState
+	logger cmtlog.Logger
+
+	// mu guards state: DeliverTx/EndBlock/BeginBlock/InitChain/Commit and
+	// snapshot restore mutate it from the ABCI callback goroutine, while
+	// the REST query server (query.go) and GraphQL resolvers
+	// (gql_adapter.go) read it from their own HTTP-handler goroutines.
+	mu        sync.RWMutex
+	state     *ApplicationState
+	smt       *store.SparseMerkleTree
+	snapshots *snapshotManager
+
+	router            *Router
+	poaiKeeper        poai.Keeper
+	aimodelKeeper     aimodel.Keeper
+	quantumKeeper     quantum.Keeper
+	poaiwitnessKeeper poaiwitness.Keeper
+	// poaiwitnessIBCModule is registered on the app's own Router (see
+	// RoutePoAIWitness) so a direct client of this chain can request a
+	// witness today. Binding it to a live IBC port/channel so a
+	// counterparty chain can reach it via OnRecvPacket requires this app
+	// to run a real ibc-go core (02-client/03-connection/04-channel
+	// keepers on a cosmos-sdk BaseApp), which this raw ABCI socket app
+	// does not; IBCModule is kept here, constructed and ready, for that
+	// migration rather than left unreferenced anywhere in app.
+	poaiwitnessIBCModule poaiwitness.IBCModule
+
+	gqlServer        *gql.Server
+	aiValidator      *aivalidator.Registry
+	aiScoreThreshold float64
 }
 
 // ApplicationState maintains the blockchain state
 type ApplicationState struct {
-	Height    int64                  `json:"height"`
-	AppHash   []byte                 `json:"app_hash"`
-	Balances  map[string]int64       `json:"balances"`
-	AIModels  map[string]*AIModel    `json:"ai_models"`
-	Quantum   *QuantumState          `json:"quantum_state"`
-	Creator   *CreatorAttribution    `json:"creator"`
-	Validators map[string]*Validator `json:"validators"`
-}
-
-// AIModel represents an AI validation model
-type AIModel struct {
-	ID          string    `json:"id"`
-	Type        string    `json:"type"`
-	Accuracy    float64   `json:"accuracy"`
-	LastUsed    time.Time `json:"last_used"`
-	Creator     string    `json:"creator"`
-	Hash        string    `json:"hash"`
-}
-
-// QuantumState maintains quantum verification state
-type QuantumState struct {
-	CircuitHash      string `json:"circuit_hash"`
-	EntanglementID   string `json:"entanglement_id"`
-	MeasurementBasis string `json:"measurement_basis"`
-	DecoherenceTime  string `json:"decoherence_time"`
+	Height     int64                       `json:"height"`
+	AppHash    []byte                      `json:"app_hash"`
+	Balances   map[string]int64            `json:"balances"`
+	AIModels   map[string]*aimodel.Model   `json:"ai_models"`
+	Quantum    *quantum.State              `json:"quantum_state"`
+	Creator    *CreatorAttribution         `json:"creator"`
+	Validators map[string]*poai.Validator  `json:"validators"`
+
+	// EpochTxTotal and EpochTxPassed accumulate since the last epoch
+	// boundary, feeding EndBlock's AI-weighted validator power update.
+	EpochTxTotal  int64 `json:"epoch_tx_total"`
+	EpochTxPassed int64 `json:"epoch_tx_passed"`
 }
 
 // CreatorAttribution ensures permanent attribution to Andrew Lee Cruz
@@ -69,17 +104,45 @@ type CreatorAttribution struct {
 	Created  string `json:"created"`
 }
 
-// Validator represents a network validator
-type Validator struct {
-	Address string `json:"address"`
-	PubKey  string `json:"pubkey"`
-	Power   int64  `json:"power"`
-	AIScore float64 `json:"ai_score"`
-}
+// NewPoAIApplication creates a new PoAI application. snapshotDir is where
+// periodic state-sync snapshots are persisted; snapshotInterval is how
+// often (in blocks) a new one is taken, with 0 disabling the feature.
+// aiOraclePubKeyHex is the hex-encoded ed25519 public key the poai
+// Keeper requires MsgUpdateValidatorAIScore.Signature to verify against;
+// empty, or invalid hex, disables AI score updates entirely rather than
+// falling back to an unauthenticated check. onnxModelDir, if non-empty,
+// is loaded as the transaction-scoring backend in place of NoopBackend;
+// if it's empty, or the model fails to load, NoopBackend is used instead.
+// witnessSigningKeyHex is a hex-encoded ed25519 private key this node
+// signs PoAIWitnessResponses with; if empty, a key is generated for this
+// process only, which is fine for a local devnet but means restarting
+// the node invalidates every witness a requester may have cached a
+// public key for, so production deployments should set it explicitly.
+func NewPoAIApplication(snapshotDir string, snapshotInterval uint64, aiOraclePubKeyHex string, onnxModelDir string, witnessSigningKeyHex string) *PoAIApplication {
+	logger := cmtlog.NewTMLogger(cmtlog.NewSyncWriter(os.Stdout))
+
+	var aiOraclePubKey ed25519.PubKey
+	if aiOraclePubKeyHex != "" {
+		keyBytes, err := hex.DecodeString(aiOraclePubKeyHex)
+		if err != nil || len(keyBytes) != ed25519.PubKeySize {
+			logger.Error("Invalid --ai-oracle-pubkey, AI score updates will be rejected", "err", err)
+		} else {
+			aiOraclePubKey = ed25519.PubKey(keyBytes)
+		}
+	}
 
-// NewPoAIApplication creates a new PoAI application
-func NewPoAIApplication() *PoAIApplication {
-	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	witnessSigningKey := ed25519.GenPrivKey()
+	if witnessSigningKeyHex != "" {
+		keyBytes, err := hex.DecodeString(witnessSigningKeyHex)
+		if err != nil || len(keyBytes) != ed25519.PrivateKeySize {
+			logger.Error("Invalid --witness-signing-key, generating an ephemeral key instead", "err", err)
+		} else {
+			witnessSigningKey = ed25519.PrivKey(keyBytes)
+		}
+	} else {
+		logger.Info("No --witness-signing-key set, generated an ephemeral poaiwitness signing key for this process")
+	}
+	logger.Info("poaiwitness signing public key", "pubkey", hex.EncodeToString(witnessSigningKey.PubKey().Bytes()))
 	
 	// Initialize with creator attribution
 	creator := &CreatorAttribution{
@@ -90,7 +153,7 @@ func NewPoAIApplication() *PoAIApplication {
 		Created: "2024-08-08T14:42:00Z",
 	}
 
-	quantum := &QuantumState{
+	quantumState := &quantum.State{
 		CircuitHash:      "quantum-circuit-hash-q1w2e3r4t5y6u7i8o9p0",
 		EntanglementID:   "entanglement-id-alice-bob-charlie-delta",
 		MeasurementBasis: "computational-z-basis-standard",
@@ -98,17 +161,17 @@ func NewPoAIApplication() *PoAIApplication {
 	}
 
 	state := &ApplicationState{
-		Height:    0,
-		AppHash:   make([]byte, 32),
-		Balances:  make(map[string]int64),
-		AIModels:  make(map[string]*AIModel),
-		Quantum:   quantum,
-		Creator:   creator,
-		Validators: make(map[string]*Validator),
+		Height:     0,
+		AppHash:    make([]byte, 32),
+		Balances:   make(map[string]int64),
+		AIModels:   make(map[string]*aimodel.Model),
+		Quantum:    quantumState,
+		Creator:    creator,
+		Validators: make(map[string]*poai.Validator),
 	}
 
 	// Initialize default AI model
-	defaultAI := &AIModel{
+	defaultAI := &aimodel.Model{
 		ID:       "poai-validator-v1",
 		Type:     "transaction-validator",
 		Accuracy: 0.99,
@@ -121,14 +184,112 @@ func NewPoAIApplication() *PoAIApplication {
 	// Initialize creator balance
 	state.Balances["andrew-lee-cruz-creator"] = 1000000000 // 1 billion tokens
 
-	return &PoAIApplication{
-		logger: logger,
-		state:  state,
+	aiValidator := aivalidator.NewRegistry()
+	if onnxModelDir != "" {
+		onnxBackend := aivalidator.NewONNXBackend(onnxModelDir)
+		if err := onnxBackend.LoadModel(defaultAI.ID); err != nil {
+			logger.Error("Failed to load ONNX model, falling back to NoopBackend", "model_dir", onnxModelDir, "model", defaultAI.ID, "err", err)
+			aiValidator.Register(defaultAI.Type, aivalidator.NoopBackend{})
+		} else {
+			aiValidator.Register(defaultAI.Type, onnxBackend)
+		}
+	} else {
+		aiValidator.Register(defaultAI.Type, aivalidator.NoopBackend{})
+	}
+
+	smt := store.NewSparseMerkleTree()
+	aimodelKeeper := aimodel.NewKeeper(smt)
+	quantumKeeper := quantum.NewKeeper(smt)
+	poaiwitnessKeeper := poaiwitness.NewKeeper(aimodelKeeper, quantumKeeper, func() int64 { return state.Height }, witnessSigningKey)
+
+	app := &PoAIApplication{
+		logger:               logger,
+		state:                state,
+		smt:                  smt,
+		snapshots:            newSnapshotManager(snapshotDir, snapshotInterval),
+		poaiKeeper:           poai.NewKeeper(smt, aiOraclePubKey),
+		aimodelKeeper:        aimodelKeeper,
+		quantumKeeper:        quantumKeeper,
+		poaiwitnessKeeper:    poaiwitnessKeeper,
+		poaiwitnessIBCModule: poaiwitness.NewIBCModule(poaiwitnessKeeper),
+		aiValidator:          aiValidator,
+		aiScoreThreshold:     defaultAIScoreThreshold,
+	}
+	app.router = NewRouter().
+		AddRoute(poai.RoutePoAI, poai.NewHandler(app.poaiKeeper)).
+		AddRoute(aimodel.RouteAIModel, aimodel.NewHandler(app.aimodelKeeper)).
+		AddRoute(quantum.RouteQuantum, quantum.NewHandler(app.quantumKeeper)).
+		AddRoute(poaiwitness.RoutePoAIWitness, poaiwitness.NewHandler(app.poaiwitnessKeeper))
+
+	app.indexAIModel(defaultAI)
+	app.indexBalance("andrew-lee-cruz-creator")
+	if err := app.quantumKeeper.SetState(*quantumState); err != nil {
+		app.logger.Error("Failed to index initial quantum state", "err", err)
+	}
+	return app
+}
+
+// EnableGraphQL builds the GraphQL server resolving against this
+// application and stores it so Commit can publish block events to its
+// `blocks` subscribers.
+func (app *PoAIApplication) EnableGraphQL(playground bool) error {
+	server, err := gql.NewServer(app, playground)
+	if err != nil {
+		return err
+	}
+	app.gqlServer = server
+	return nil
+}
+
+// indexAIModel writes an AI model record into the authenticated store so it
+// can be proven to light clients via Query(prove=true).
+func (app *PoAIApplication) indexAIModel(model *aimodel.Model) {
+	data, _ := json.Marshal(model)
+	app.smt.Set("aimodel/"+model.ID, data)
+}
+
+// indexBalance writes an account's current balance into the authenticated
+// store, keyed by address.
+func (app *PoAIApplication) indexBalance(addr string) {
+	data, _ := json.Marshal(app.state.Balances[addr])
+	app.smt.Set("balance/"+addr, data)
+}
+
+// indexValidator writes a validator record into the authenticated store,
+// keyed by address.
+func (app *PoAIApplication) indexValidator(val *poai.Validator) {
+	data, _ := json.Marshal(val)
+	app.smt.Set("validator/"+val.Address, data)
+}
+
+// reindexSMT re-seeds the SMT-backed store from state. ApplySnapshotChunk
+// calls this after replacing app.state from a restored snapshot: the SMT
+// itself isn't part of the snapshot payload, so without this the tree
+// stays whatever this node booted with (effectively empty), Commit's next
+// AppHash wouldn't match the rest of the network, and Query(prove=true)
+// would keep returning stale or missing data for every restored record.
+func (app *PoAIApplication) reindexSMT(state *ApplicationState) {
+	for _, model := range state.AIModels {
+		app.indexAIModel(model)
+	}
+	for _, v := range state.Validators {
+		app.indexValidator(v)
+	}
+	for addr := range state.Balances {
+		app.indexBalance(addr)
+	}
+	if state.Quantum != nil {
+		if err := app.quantumKeeper.SetState(*state.Quantum); err != nil {
+			app.logger.Error("Failed to reindex quantum state after snapshot restore", "err", err)
+		}
 	}
 }
 
 // Info returns information about the application
 func (app *PoAIApplication) Info(req abcitypes.RequestInfo) abcitypes.ResponseInfo {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
 	return abcitypes.ResponseInfo{
 		Data:             "PoAI Zero-Mining Blockchain",
 		Version:          "1.0.0",
@@ -140,6 +301,9 @@ func (app *PoAIApplication) Info(req abcitypes.RequestInfo) abcitypes.ResponseIn
 
 // InitChain initializes the blockchain
 func (app *PoAIApplication) InitChain(req abcitypes.RequestInitChain) abcitypes.ResponseInitChain {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
 	app.logger.Info("Initializing PoAI Chain",
 		"creator", app.state.Creator.Name,
 		"uid", app.state.Creator.UID,
@@ -155,12 +319,15 @@ func (app *PoAIApplication) InitChain(req abcitypes.RequestInitChain) abcitypes.
 		// Store validator info
 		pubKeyBytes := val.PubKey.GetEd25519()
 		addr := crypto.Address(pubKeyBytes).String()
-		app.state.Validators[addr] = &Validator{
-			Address: addr,
-			PubKey:  fmt.Sprintf("%x", pubKeyBytes),
-			Power:   val.Power,
-			AIScore: 1.0, // Default AI validation score
+		validator := &poai.Validator{
+			Address:   addr,
+			PubKey:    fmt.Sprintf("%x", pubKeyBytes),
+			Power:     val.Power,
+			BasePower: val.Power,
+			AIScore:   1.0, // Default AI validation score
 		}
+		app.state.Validators[addr] = validator
+		app.indexValidator(validator)
 	}
 
 	return abcitypes.ResponseInitChain{
@@ -168,62 +335,157 @@ func (app *PoAIApplication) InitChain(req abcitypes.RequestInitChain) abcitypes.
 	}
 }
 
-// CheckTx validates transactions using AI
+// CheckTx validates transactions. Typed x/poai, x/aimodel, or x/quantum
+// messages are decoded and run through ValidateBasic; anything else falls
+// back to the legacy free-form AI/quantum validation path.
 func (app *PoAIApplication) CheckTx(req abcitypes.RequestCheckTx) abcitypes.ResponseCheckTx {
-	// Parse transaction
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	if msg, err := decodeMsg(req.Tx); err == nil {
+		if err := msg.ValidateBasic(); err != nil {
+			return abcitypes.ResponseCheckTx{Code: 1, Log: err.Error()}
+		}
+		return abcitypes.ResponseCheckTx{Code: 0, Log: fmt.Sprintf("message %q validated", msg.Type())}
+	}
+
 	tx := string(req.Tx)
 	app.logger.Info("Checking transaction with AI validation", "tx", tx)
 
-	// AI validation simulation
-	aiValid := app.validateWithAI(tx)
+	result, err := app.scoreWithAI(context.Background(), req.Tx)
 	quantumValid := app.validateWithQuantum(tx)
 
-	if !aiValid || !quantumValid {
+	if err != nil || result.Score < app.aiScoreThreshold || !quantumValid {
 		return abcitypes.ResponseCheckTx{
 			Code: 1,
 			Log:  "Transaction failed AI or quantum validation",
 		}
 	}
 
+	app.logger.Info("Transaction scored by AI validator",
+		"model", result.ModelID, "score", result.Score, "features", result.Features)
+
 	return abcitypes.ResponseCheckTx{
 		Code: 0,
 		Log:  "Transaction validated by AI and quantum systems",
 	}
 }
 
-// DeliverTx executes transactions
+// scoreWithAI runs tx through the aivalidator.Registry's backend(s) for
+// the default PoAI validation model, replacing the old fixed length
+// heuristic with a pluggable, deterministically cross-checked score.
+func (app *PoAIApplication) scoreWithAI(ctx context.Context, tx []byte) (aivalidator.Result, error) {
+	model := app.state.AIModels["poai-validator-v1"]
+	if model == nil {
+		return aivalidator.Result{}, fmt.Errorf("poai-validator-v1 model not registered")
+	}
+	model.LastUsed = time.Now()
+	return app.aiValidator.Score(ctx, model.Type, model.ID, tx)
+}
+
+// DeliverTx executes transactions. Typed module messages are routed
+// through the Router to their module's Handler; anything else falls back
+// to the legacy free-form execution path.
 func (app *PoAIApplication) DeliverTx(req abcitypes.RequestDeliverTx) abcitypes.ResponseDeliverTx {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	if msg, err := decodeMsg(req.Tx); err == nil {
+		if err := msg.ValidateBasic(); err != nil {
+			return abcitypes.ResponseDeliverTx{Code: 1, Log: err.Error()}
+		}
+		handler := app.router.Route(msg.Route())
+		if handler == nil {
+			return abcitypes.ResponseDeliverTx{Code: 1, Log: fmt.Sprintf("unrecognized route %q", msg.Route())}
+		}
+		result, err := handler(msg)
+		if err != nil {
+			return abcitypes.ResponseDeliverTx{Code: 1, Log: err.Error()}
+		}
+		app.mirrorLegacyState(msg)
+		return abcitypes.ResponseDeliverTx{Code: 0, Log: result.Log, Data: result.Data}
+	}
+
 	tx := string(req.Tx)
 	app.logger.Info("Delivering transaction", "tx", tx)
 
-	// Execute transaction logic here
-	// For demo, just log the transaction
+	result, err := app.scoreWithAI(context.Background(), req.Tx)
+	passed := err == nil && result.Score >= app.aiScoreThreshold && app.validateWithQuantum(tx)
+	app.state.EpochTxTotal++
+	if passed {
+		app.state.EpochTxPassed++
+	}
+
 	return abcitypes.ResponseDeliverTx{
 		Code: 0,
 		Log:  fmt.Sprintf("Transaction executed: %s", tx),
 	}
 }
 
-// Commit commits the current state
+// mirrorLegacyState keeps the ApplicationState caches used by Info/Query
+// and EndBlock in sync with a message the Router just applied to the
+// authoritative keeper-backed store.
+func (app *PoAIApplication) mirrorLegacyState(msg types.Msg) {
+	switch msg := msg.(type) {
+	case aimodel.MsgRegisterAIModel:
+		if model, ok := app.aimodelKeeper.GetModel(msg.ID); ok {
+			app.state.AIModels[msg.ID] = &model
+		}
+	case quantum.MsgCommitQuantumCircuit:
+		if state, ok := app.quantumKeeper.GetState(); ok {
+			app.state.Quantum = &state
+		}
+	case poai.MsgUpdateValidatorAIScore:
+		if v, ok := app.state.Validators[msg.Address]; ok {
+			v.AIScore = msg.AIScore
+		}
+	}
+}
+
+// Commit commits the current state. The app hash is the root of the
+// Sparse Merkle Tree that indexes AI models, validators, and balances, so
+// light clients can verify individual records with Query(prove=true)
+// instead of trusting the full state blob.
 func (app *PoAIApplication) Commit() abcitypes.ResponseCommit {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
 	app.state.Height++
-	
-	// Generate new app hash
-	stateBytes, _ := json.Marshal(app.state)
-	app.state.AppHash = crypto.Sha256(stateBytes)
+	app.state.AppHash = app.smt.Root()
 
 	app.logger.Info("Committing state",
 		"height", app.state.Height,
 		"app_hash", fmt.Sprintf("%x", app.state.AppHash),
 		"creator", app.state.Creator.Name)
 
+	if app.snapshots.ShouldSnapshot(app.state.Height) {
+		if mf, err := app.snapshots.Create(app.state.Height, app.state); err != nil {
+			app.logger.Error("Failed to create state sync snapshot", "height", app.state.Height, "err", err)
+		} else {
+			app.logger.Info("Created state sync snapshot", "height", app.state.Height, "chunks", len(mf.ChunkHashes))
+		}
+	}
+
+	if app.gqlServer != nil {
+		app.gqlServer.Publish(gql.BlockEvent{
+			Height:  app.state.Height,
+			AppHash: fmt.Sprintf("%x", app.state.AppHash),
+		})
+	}
+
 	return abcitypes.ResponseCommit{
 		Data: app.state.AppHash,
 	}
 }
 
-// Query handles queries
+// Query handles queries. Paths of the form "aimodel/<id>", "validator/<addr>"
+// and "balance/<addr>" are served from the SMT-backed store; setting
+// req.Prove additionally returns a SparseMerkleProof against the last
+// committed AppHash so the result can be verified by a light client.
 func (app *PoAIApplication) Query(req abcitypes.RequestQuery) abcitypes.ResponseQuery {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
 	switch req.Path {
 	case "creator":
 		data, _ := json.Marshal(app.state.Creator)
@@ -238,26 +500,42 @@ func (app *PoAIApplication) Query(req abcitypes.RequestQuery) abcitypes.Response
 		data, _ := json.Marshal(app.state)
 		return abcitypes.ResponseQuery{Code: 0, Value: data}
 	default:
-		return abcitypes.ResponseQuery{
-			Code: 1,
-			Log:  "Unknown query path",
-		}
+		return app.queryStore(req)
 	}
 }
 
-// validateWithAI simulates AI validation
-func (app *PoAIApplication) validateWithAI(tx string) bool {
-	// Simulate AI validation
-	model := app.state.AIModels["poai-validator-v1"]
-	if model == nil {
-		return false
+// queryStore looks up req.Path directly in the SMT, treating it as a store
+// key (e.g. "aimodel/poai-validator-v1"). It is the only path that honors
+// req.Prove.
+func (app *PoAIApplication) queryStore(req abcitypes.RequestQuery) abcitypes.ResponseQuery {
+	value, err := app.smt.Get(req.Path)
+	if err != nil {
+		return abcitypes.ResponseQuery{Code: 1, Log: "Unknown query path"}
 	}
-	
-	// Update last used time
-	model.LastUsed = time.Now()
-	
-	// Simulate validation (in real implementation, this would call ML model)
-	return len(tx) > 0 && len(tx) < 1000
+
+	resp := abcitypes.ResponseQuery{
+		Code:   0,
+		Key:    []byte(req.Path),
+		Value:  value,
+		Height: app.state.Height,
+	}
+
+	if req.Prove {
+		proof, err := app.smt.Prove(req.Path)
+		if err != nil {
+			return abcitypes.ResponseQuery{Code: 1, Log: fmt.Sprintf("failed to build proof: %v", err)}
+		}
+		proofBytes, _ := json.Marshal(proof)
+		resp.ProofOps = &cmtmerkle.ProofOps{
+			Ops: []cmtmerkle.ProofOp{{
+				Type: "smt:v1",
+				Key:  []byte(req.Path),
+				Data: proofBytes,
+			}},
+		}
+	}
+
+	return resp
 }
 
 // validateWithQuantum simulates quantum validation
@@ -267,40 +545,111 @@ func (app *PoAIApplication) validateWithQuantum(tx string) bool {
 	return app.state.Quantum.CircuitHash != ""
 }
 
-// Additional ABCI methods with minimal implementations
-func (app *PoAIApplication) BeginBlock(req abcitypes.RequestBeginBlock) abcitypes.ResponseBeginBlock {
-	return abcitypes.ResponseBeginBlock{}
-}
-
-func (app *PoAIApplication) EndBlock(req abcitypes.RequestEndBlock) abcitypes.ResponseEndBlock {
-	return abcitypes.ResponseEndBlock{}
-}
-
+// ListSnapshots advertises the recent snapshots this node can serve to a
+// peer that is state-syncing instead of replaying every block.
 func (app *PoAIApplication) ListSnapshots(req abcitypes.RequestListSnapshots) abcitypes.ResponseListSnapshots {
-	return abcitypes.ResponseListSnapshots{}
+	return abcitypes.ResponseListSnapshots{Snapshots: app.snapshots.List()}
 }
 
+// OfferSnapshot validates a peer-offered snapshot manifest against the
+// height's trusted AppHash before this node commits to downloading it.
 func (app *PoAIApplication) OfferSnapshot(req abcitypes.RequestOfferSnapshot) abcitypes.ResponseOfferSnapshot {
-	return abcitypes.ResponseOfferSnapshot{}
+	if app.snapshots.Offer(req.Snapshot, req.AppHash) {
+		return abcitypes.ResponseOfferSnapshot{Result: abcitypes.ResponseOfferSnapshot_ACCEPT}
+	}
+	return abcitypes.ResponseOfferSnapshot{Result: abcitypes.ResponseOfferSnapshot_REJECT}
 }
 
+// LoadSnapshotChunk serves one chunk of a locally persisted snapshot to a
+// state-syncing peer.
 func (app *PoAIApplication) LoadSnapshotChunk(req abcitypes.RequestLoadSnapshotChunk) abcitypes.ResponseLoadSnapshotChunk {
-	return abcitypes.ResponseLoadSnapshotChunk{}
+	chunk, err := app.snapshots.LoadChunk(int64(req.Height), req.Format, req.Chunk)
+	if err != nil {
+		app.logger.Error("Failed to load snapshot chunk", "height", req.Height, "chunk", req.Chunk, "err", err)
+		return abcitypes.ResponseLoadSnapshotChunk{}
+	}
+	return abcitypes.ResponseLoadSnapshotChunk{Chunk: chunk}
 }
 
+// ApplySnapshotChunk verifies and reassembles chunks of a snapshot this
+// node is restoring from. Peers that send a chunk failing its hash check
+// are rejected via REJECT_SENDER so CometBFT stops sourcing chunks from
+// them.
 func (app *PoAIApplication) ApplySnapshotChunk(req abcitypes.RequestApplySnapshotChunk) abcitypes.ResponseApplySnapshotChunk {
-	return abcitypes.ResponseApplySnapshotChunk{}
+	result, restored, err := app.snapshots.ApplyChunk(req.Index, req.Chunk)
+	switch result {
+	case applyChunkRejectSender:
+		app.logger.Error("Rejecting snapshot chunk sender", "sender", req.Sender, "err", err)
+		return abcitypes.ResponseApplySnapshotChunk{
+			Result:        abcitypes.ResponseApplySnapshotChunk_REJECT_SNAPSHOT,
+			RejectSenders: []string{req.Sender},
+		}
+	case applyChunkComplete:
+		app.mu.Lock()
+		app.state = restored
+		app.reindexSMT(restored)
+		app.mu.Unlock()
+		app.logger.Info("Restored state from snapshot", "height", app.state.Height)
+		return abcitypes.ResponseApplySnapshotChunk{Result: abcitypes.ResponseApplySnapshotChunk_ACCEPT}
+	default:
+		return abcitypes.ResponseApplySnapshotChunk{Result: abcitypes.ResponseApplySnapshotChunk_ACCEPT}
+	}
 }
 
 func main() {
 	var socketAddr string
+	var snapshotDir string
+	var snapshotInterval uint64
+	var restAddr string
+	var gqlServerEnabled bool
+	var gqlPlayground bool
+	var gqlAddr string
+	var aiOraclePubKeyHex string
+	var onnxModelDir string
+	var witnessSigningKeyHex string
 	flag.StringVar(&socketAddr, "socket-addr", "unix:///tmp/poai.sock", "Socket address for ABCI server")
+	flag.StringVar(&snapshotDir, "snapshot-dir", "./snapshots", "Directory to persist state sync snapshots in")
+	flag.Uint64Var(&snapshotInterval, "snapshot-interval", 1000, "Take a state sync snapshot every N blocks (0 disables)")
+	flag.StringVar(&restAddr, "rest-addr", "", "Address to serve the /poai REST query API on (empty disables it)")
+	flag.BoolVar(&gqlServerEnabled, "gql-server", false, "Serve chain state over GraphQL alongside the ABCI socket")
+	flag.BoolVar(&gqlPlayground, "gql-playground", false, "Serve the GraphQL playground (requires --gql-server)")
+	flag.StringVar(&gqlAddr, "gql-addr", ":"+gql.DefaultPort, "Address to serve the GraphQL server on")
+	flag.StringVar(&aiOraclePubKeyHex, "ai-oracle-pubkey", defaultAIOraclePubKeyHex, "Hex-encoded ed25519 public key that must sign MsgUpdateValidatorAIScore (empty rejects all AI score updates)")
+	// aivalidator.GRPCBackend has no flag here: it needs an
+	// InferenceServiceClient built from the generated poai.v1.Inference
+	// client (see aivalidator.NewGRPCBackend's doc comment), and this repo
+	// has no protoc toolchain to generate one yet. Wire a --grpc-* flag set
+	// once that adapter exists instead of constructing a client that can
+	// only ever be a stub.
+	flag.StringVar(&onnxModelDir, "onnx-model-dir", "", "Directory of <model-id>.onnx files to score transactions with (empty uses the built-in length heuristic)")
+	flag.StringVar(&witnessSigningKeyHex, "witness-signing-key", "", "Hex-encoded ed25519 private key to sign PoAIWitnessResponses with (empty generates an ephemeral key for this process)")
 	flag.Parse()
 
-	app := NewPoAIApplication()
-	
-	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
-	
+	app := NewPoAIApplication(snapshotDir, snapshotInterval, aiOraclePubKeyHex, onnxModelDir, witnessSigningKeyHex)
+
+	logger := cmtlog.NewTMLogger(cmtlog.NewSyncWriter(os.Stdout))
+
+	if restAddr != "" {
+		go func() {
+			if err := http.ListenAndServe(restAddr, newQueryServer(app).routes()); err != nil {
+				logger.Error("REST query server stopped", "err", err)
+			}
+		}()
+		logger.Info("PoAI REST query API started", "addr", restAddr)
+	}
+
+	if gqlServerEnabled {
+		if err := app.EnableGraphQL(gqlPlayground); err != nil {
+			log.Fatalf("Failed to start GraphQL server: %v", err)
+		}
+		go func() {
+			if err := app.gqlServer.ListenAndServe(gqlAddr); err != nil {
+				logger.Error("GraphQL server stopped", "err", err)
+			}
+		}()
+		logger.Info("PoAI GraphQL server started", "addr", gqlAddr, "playground", gqlPlayground)
+	}
+
 	// Create ABCI server
 	server := abciclient.NewSocketClient(socketAddr, false)
 	server.SetLogger(logger.With("module", "abci-client"))
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+)
+
+// queryServer exposes read-only REST endpoints backed by the same keepers
+// DeliverTx routes typed messages through, for clients that would rather
+// not speak the ABCI Query socket directly.
+type queryServer struct {
+	app *PoAIApplication
+}
+
+func newQueryServer(app *PoAIApplication) *queryServer {
+	return &queryServer{app: app}
+}
+
+// routes returns the REST mux: GET /poai/models/{id}, GET /poai/validators,
+// GET /poai/quantum/state, GET /poai/witness/pubkey.
+func (q *queryServer) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/poai/models/", q.handleModel)
+	mux.HandleFunc("/poai/validators", q.handleValidators)
+	mux.HandleFunc("/poai/quantum/state", q.handleQuantumState)
+	mux.HandleFunc("/poai/witness/pubkey", q.handleWitnessPubKey)
+	return mux
+}
+
+func (q *queryServer) handleModel(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/poai/models/")
+	q.app.mu.RLock()
+	model, ok := q.app.aimodelKeeper.GetModel(id)
+	q.app.mu.RUnlock()
+	if !ok {
+		http.Error(w, "model not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, model)
+}
+
+func (q *queryServer) handleValidators(w http.ResponseWriter, r *http.Request) {
+	q.app.mu.RLock()
+	validators := make(map[string]*poai.Validator, len(q.app.state.Validators))
+	for addr, v := range q.app.state.Validators {
+		cp := *v
+		validators[addr] = &cp
+	}
+	q.app.mu.RUnlock()
+	writeJSON(w, validators)
+}
+
+func (q *queryServer) handleQuantumState(w http.ResponseWriter, r *http.Request) {
+	q.app.mu.RLock()
+	state, ok := q.app.quantumKeeper.GetState()
+	q.app.mu.RUnlock()
+	if !ok {
+		http.Error(w, "quantum state not set", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, state)
+}
+
+// handleWitnessPubKey returns the public key PoAIWitnessResponse.Signature
+// verifies against, so a requester can fetch it once (out of band from
+// the response itself) rather than trusting a key embedded in the
+// response it's trying to verify. poaiwitnessKeeper's signing key is
+// fixed at construction, so this doesn't need app.mu.
+func (q *queryServer) handleWitnessPubKey(w http.ResponseWriter, r *http.Request) {
+	pubKey := q.app.poaiwitnessKeeper.PubKey()
+	writeJSON(w, map[string]string{"pubkey": hex.EncodeToString(pubKey)})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
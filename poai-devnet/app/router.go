@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// Router dispatches a Msg to the handler registered for its Route,
+// mirroring baseapp.Router in the Cosmos SDK. It is a scoped-down stand-in,
+// not a BaseApp migration: PoAIApplication still runs as a hand-rolled
+// ABCI socket app (see main.go), decodeMsg's txEnvelope (app/codec.go) is
+// a JSON type tag rather than Amino/proto codecs, and queries are served
+// over REST (app/query.go) rather than registered gRPC query handlers.
+// Modules are wired into this Router and gated by typed Msg/Keeper/Handler
+// the same way a real Cosmos SDK module would be; migrating the app
+// itself onto BaseApp is still open work.
+type Router struct {
+	routes map[string]types.Handler
+}
+
+// NewRouter returns an empty Router.
+func NewRouter() *Router {
+	return &Router{routes: make(map[string]types.Handler)}
+}
+
+// AddRoute registers h as the handler for route. It panics if route is
+// already registered, matching baseapp.Router's fail-fast behavior at
+// startup.
+func (r *Router) AddRoute(route string, h types.Handler) *Router {
+	if _, ok := r.routes[route]; ok {
+		panic(fmt.Sprintf("route %q is already registered", route))
+	}
+	r.routes[route] = h
+	return r
+}
+
+// Route returns the handler registered for route, or nil if none is.
+func (r *Router) Route(route string) types.Handler {
+	return r.routes[route]
+}
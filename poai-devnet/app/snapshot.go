@@ -0,0 +1,297 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+)
+
+const (
+	// snapshotChunkSize is the maximum size of a single serialized state
+	// chunk handed to ApplySnapshotChunk/LoadSnapshotChunk.
+	snapshotChunkSize = 16 * 1024 * 1024 // 16 MiB
+	// snapshotFormat versions the encoding of a chunk. Bump it if the chunk
+	// layout ever changes so old snapshots are rejected instead of
+	// misinterpreted.
+	snapshotFormat = 1
+	// keepManifests bounds how many recent snapshots ListSnapshots offers,
+	// so the snapshot directory doesn't grow without bound.
+	keepManifests = 4
+)
+
+// snapshotManifest describes one snapshot: the height it was taken at, the
+// chunks that make it up, and the hashes needed to verify them.
+type snapshotManifest struct {
+	Height      int64    `json:"height"`
+	Format      uint32   `json:"format"`
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+	Hash        []byte   `json:"hash"` // sha256 over the concatenated chunk hashes
+}
+
+// restoreState tracks an in-progress ApplySnapshotChunk sequence offered by
+// OfferSnapshot. total is the chunk count CometBFT reports on the
+// abcitypes.Snapshot itself (the only count ABCI actually guarantees);
+// manifest.ChunkHashes is only as complete as what the snapshot's
+// Metadata carried, and may be empty if the peer didn't supply one.
+type restoreState struct {
+	manifest snapshotManifest
+	total    uint32
+	chunks   map[uint32][]byte
+}
+
+// snapshotManager persists periodic state snapshots to disk for ABCI state
+// sync, and reassembles snapshots offered by peers when this node is
+// bootstrapping instead of replaying every block.
+type snapshotManager struct {
+	dir      string
+	interval uint64
+
+	manifests []snapshotManifest
+	restoring *restoreState
+}
+
+// newSnapshotManager returns a manager rooted at dir, taking a new snapshot
+// every interval blocks. interval == 0 disables automatic snapshotting.
+func newSnapshotManager(dir string, interval uint64) *snapshotManager {
+	_ = os.MkdirAll(dir, 0o755)
+	m := &snapshotManager{dir: dir, interval: interval}
+	m.manifests = m.loadManifests()
+	return m
+}
+
+func (m *snapshotManager) heightDir(height int64) string {
+	return filepath.Join(m.dir, fmt.Sprintf("%d", height))
+}
+
+func (m *snapshotManager) manifestPath(height int64) string {
+	return filepath.Join(m.heightDir(height), "manifest.json")
+}
+
+func (m *snapshotManager) chunkPath(height int64, chunk uint32) string {
+	return filepath.Join(m.heightDir(height), fmt.Sprintf("chunk-%d", chunk))
+}
+
+// loadManifests rebuilds the in-memory manifest index from whatever
+// snapshot directories already exist on disk, so a restarted node doesn't
+// forget snapshots it already took.
+func (m *snapshotManager) loadManifests() []snapshotManifest {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		return nil
+	}
+	var out []snapshotManifest
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		var height int64
+		if _, err := fmt.Sscanf(e.Name(), "%d", &height); err != nil {
+			continue
+		}
+		data, err := os.ReadFile(m.manifestPath(height))
+		if err != nil {
+			continue
+		}
+		var mf snapshotManifest
+		if err := json.Unmarshal(data, &mf); err != nil {
+			continue
+		}
+		out = append(out, mf)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Height < out[j].Height })
+	return out
+}
+
+// ShouldSnapshot reports whether height is a multiple of the configured
+// interval and thus due for a new snapshot.
+func (m *snapshotManager) ShouldSnapshot(height int64) bool {
+	return m.interval > 0 && height > 0 && uint64(height)%m.interval == 0
+}
+
+// Create serializes state, splits it into fixed-size chunks, persists them
+// plus a manifest under dir/<height>/, and prunes older snapshots beyond
+// keepManifests.
+func (m *snapshotManager) Create(height int64, state *ApplicationState) (snapshotManifest, error) {
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return snapshotManifest{}, err
+	}
+
+	if err := os.MkdirAll(m.heightDir(height), 0o755); err != nil {
+		return snapshotManifest{}, err
+	}
+
+	var chunkHashes [][]byte
+	for i := 0; i*snapshotChunkSize < len(stateBytes) || i == 0; i++ {
+		start := i * snapshotChunkSize
+		if start >= len(stateBytes) {
+			break
+		}
+		end := start + snapshotChunkSize
+		if end > len(stateBytes) {
+			end = len(stateBytes)
+		}
+		chunk := stateBytes[start:end]
+		if err := os.WriteFile(m.chunkPath(height, uint32(i)), chunk, 0o644); err != nil {
+			return snapshotManifest{}, err
+		}
+		sum := sha256.Sum256(chunk)
+		chunkHashes = append(chunkHashes, sum[:])
+	}
+
+	h := sha256.New()
+	for _, ch := range chunkHashes {
+		h.Write(ch)
+	}
+
+	mf := snapshotManifest{
+		Height:      height,
+		Format:      snapshotFormat,
+		ChunkHashes: chunkHashes,
+		Hash:        h.Sum(nil),
+	}
+
+	manifestBytes, err := json.Marshal(mf)
+	if err != nil {
+		return snapshotManifest{}, err
+	}
+	if err := os.WriteFile(m.manifestPath(height), manifestBytes, 0o644); err != nil {
+		return snapshotManifest{}, err
+	}
+
+	m.manifests = append(m.manifests, mf)
+	m.prune()
+	return mf, nil
+}
+
+// prune keeps only the newest keepManifests snapshots, deleting older ones
+// from disk so the snapshot directory doesn't grow forever.
+func (m *snapshotManager) prune() {
+	sort.Slice(m.manifests, func(i, j int) bool { return m.manifests[i].Height < m.manifests[j].Height })
+	for len(m.manifests) > keepManifests {
+		stale := m.manifests[0]
+		m.manifests = m.manifests[1:]
+		_ = os.RemoveAll(m.heightDir(stale.Height))
+	}
+}
+
+// List returns ABCI snapshot descriptors for the retained manifests, most
+// recent first, as expected by ListSnapshots. The per-chunk hash list
+// travels in Metadata, the only field ABCI reserves for app-defined
+// snapshot data; Offer unpacks it back out on the restoring side.
+func (m *snapshotManager) List() []*abcitypes.Snapshot {
+	out := make([]*abcitypes.Snapshot, 0, len(m.manifests))
+	for i := len(m.manifests) - 1; i >= 0; i-- {
+		mf := m.manifests[i]
+		metadata, _ := json.Marshal(mf.ChunkHashes)
+		out = append(out, &abcitypes.Snapshot{
+			Height:   uint64(mf.Height),
+			Format:   mf.Format,
+			Chunks:   uint32(len(mf.ChunkHashes)),
+			Hash:     mf.Hash,
+			Metadata: metadata,
+		})
+	}
+	return out
+}
+
+// LoadChunk reads one previously persisted chunk back off disk for
+// LoadSnapshotChunk.
+func (m *snapshotManager) LoadChunk(height int64, format, chunk uint32) ([]byte, error) {
+	if format != snapshotFormat {
+		return nil, fmt.Errorf("snapshot: unsupported format %d", format)
+	}
+	return os.ReadFile(m.chunkPath(height, chunk))
+}
+
+// Offer begins a restore: it accepts snapshot only if its declared overall
+// hash matches trustedAppHash, i.e. an AppHash this node already trusts
+// (from a trusted height, light client checkpoint, etc), and if it
+// declares at least one chunk. The per-chunk hash list, if the peer
+// supplied one in Metadata, is unpacked here so ApplyChunk can verify
+// each chunk as it arrives instead of only at the end.
+func (m *snapshotManager) Offer(snapshot *abcitypes.Snapshot, trustedAppHash []byte) bool {
+	if snapshot == nil || snapshot.Format != snapshotFormat || snapshot.Chunks == 0 {
+		return false
+	}
+	if len(trustedAppHash) > 0 && string(snapshot.Hash) != string(trustedAppHash) {
+		return false
+	}
+
+	var chunkHashes [][]byte
+	if len(snapshot.Metadata) > 0 {
+		if err := json.Unmarshal(snapshot.Metadata, &chunkHashes); err != nil {
+			return false
+		}
+	}
+
+	m.restoring = &restoreState{
+		manifest: snapshotManifest{
+			Height:      int64(snapshot.Height),
+			Format:      snapshot.Format,
+			ChunkHashes: chunkHashes,
+			Hash:        snapshot.Hash,
+		},
+		total:  snapshot.Chunks,
+		chunks: make(map[uint32][]byte),
+	}
+	return true
+}
+
+// applyChunkResult mirrors the subset of ABCI's ApplySnapshotChunk result
+// codes this manager can produce.
+type applyChunkResult int
+
+const (
+	applyChunkAccept applyChunkResult = iota
+	applyChunkRejectSender
+	applyChunkComplete
+)
+
+// ApplyChunk verifies and records one chunk of an in-progress restore. It
+// rejects the sending peer outright if the chunk doesn't match the
+// manifest's chunk hash (when one was supplied) or if a chunk is missing
+// once every index up to the snapshot's declared Chunks count has been
+// seen, and reports applyChunkComplete once the reassembled state is
+// parseable.
+func (m *snapshotManager) ApplyChunk(index uint32, data []byte) (applyChunkResult, *ApplicationState, error) {
+	if m.restoring == nil {
+		return applyChunkRejectSender, nil, fmt.Errorf("snapshot: no restore in progress")
+	}
+
+	if chunks := m.restoring.manifest.ChunkHashes; int(index) < len(chunks) {
+		sum := sha256.Sum256(data)
+		if string(chunks[index]) != string(sum[:]) {
+			return applyChunkRejectSender, nil, fmt.Errorf("snapshot: chunk %d hash mismatch", index)
+		}
+	}
+
+	m.restoring.chunks[index] = data
+
+	total := int(m.restoring.total)
+	if len(m.restoring.chunks) < total {
+		return applyChunkAccept, nil, nil
+	}
+
+	var full []byte
+	for i := 0; i < total; i++ {
+		chunk, ok := m.restoring.chunks[uint32(i)]
+		if !ok {
+			return applyChunkRejectSender, nil, fmt.Errorf("snapshot: missing chunk %d", i)
+		}
+		full = append(full, chunk...)
+	}
+
+	var state ApplicationState
+	if err := json.Unmarshal(full, &state); err != nil {
+		return applyChunkRejectSender, nil, err
+	}
+
+	m.restoring = nil
+	return applyChunkComplete, &state, nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+)
+
+// applySnapshot fetches every chunk snap describes from src and feeds them
+// into dst's OfferSnapshot/ApplySnapshotChunk, as CometBFT would during
+// state sync between two distinct peers.
+func applySnapshot(t *testing.T, src, dst *PoAIApplication, snap *abcitypes.Snapshot) {
+	t.Helper()
+
+	offer := dst.OfferSnapshot(abcitypes.RequestOfferSnapshot{Snapshot: snap, AppHash: snap.Hash})
+	if offer.Result != abcitypes.ResponseOfferSnapshot_ACCEPT {
+		t.Fatalf("OfferSnapshot result = %v, want ACCEPT", offer.Result)
+	}
+
+	for i := uint32(0); i < snap.Chunks; i++ {
+		chunk := src.LoadSnapshotChunk(abcitypes.RequestLoadSnapshotChunk{
+			Height: snap.Height,
+			Format: snap.Format,
+			Chunk:  i,
+		})
+		apply := dst.ApplySnapshotChunk(abcitypes.RequestApplySnapshotChunk{Index: i, Chunk: chunk.Chunk})
+		if apply.Result != abcitypes.ResponseApplySnapshotChunk_ACCEPT {
+			t.Fatalf("ApplySnapshotChunk(%d) result = %v, want ACCEPT", i, apply.Result)
+		}
+	}
+}
+
+// TestSnapshotRestoreReindexesSMT verifies that a node state-syncing from a
+// snapshot ends up with the same AppHash as the node that produced it, and
+// can serve proven queries for data that only existed in the snapshot, not
+// this process's own history. Before reindexSMT was added to
+// ApplySnapshotChunk, app.smt kept only what NewPoAIApplication seeded it
+// with across a restore and both of these would fail.
+func TestSnapshotRestoreReindexesSMT(t *testing.T) {
+	src := NewPoAIApplication(t.TempDir(), 1, "", "", "")
+	src.InitChain(abcitypes.RequestInitChain{})
+
+	validator := &poai.Validator{Address: "validator-1", Power: 10, BasePower: 10, AIScore: 1}
+	src.state.Validators[validator.Address] = validator
+	src.indexValidator(validator)
+
+	srcCommit := src.Commit()
+
+	snaps := src.ListSnapshots(abcitypes.RequestListSnapshots{}).Snapshots
+	if len(snaps) != 1 {
+		t.Fatalf("got %d snapshots, want 1", len(snaps))
+	}
+	snap := snaps[0]
+
+	dst := NewPoAIApplication(t.TempDir(), 0, "", "", "")
+	applySnapshot(t, src, dst, snap)
+
+	if _, ok := dst.state.Validators[validator.Address]; !ok {
+		t.Fatal("restored state is missing the validator ApplySnapshotChunk should have restored")
+	}
+
+	dstCommit := dst.Commit()
+	if string(dstCommit.Data) != string(srcCommit.Data) {
+		t.Errorf("AppHash after restore = %x, want %x (source's AppHash)", dstCommit.Data, srcCommit.Data)
+	}
+
+	resp := dst.Query(abcitypes.RequestQuery{Path: "validator/" + validator.Address, Prove: true})
+	if resp.Code != 0 {
+		t.Fatalf("Query(validator/%s) after restore failed: %s", validator.Address, resp.Log)
+	}
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		t.Error("Query with Prove=true after restore returned no proof")
+	}
+}
@@ -0,0 +1,138 @@
+package gql
+
+import "github.com/graphql-go/graphql"
+
+var statusType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Status",
+	Fields: graphql.Fields{
+		"height":  &graphql.Field{Type: graphql.Int},
+		"appHash": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var aiModelType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "AIModel",
+	Fields: graphql.Fields{
+		"id":       &graphql.Field{Type: graphql.String},
+		"type":     &graphql.Field{Type: graphql.String},
+		"accuracy": &graphql.Field{Type: graphql.Float},
+		"creator":  &graphql.Field{Type: graphql.String},
+		"hash":     &graphql.Field{Type: graphql.String},
+	},
+})
+
+var validatorType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Validator",
+	Fields: graphql.Fields{
+		"address": &graphql.Field{Type: graphql.String},
+		"pubkey":  &graphql.Field{Type: graphql.String},
+		"power":   &graphql.Field{Type: graphql.Int},
+		"aiScore": &graphql.Field{Type: graphql.Float},
+	},
+})
+
+var creatorType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Creator",
+	Fields: graphql.Fields{
+		"name":    &graphql.Field{Type: graphql.String},
+		"uid":     &graphql.Field{Type: graphql.String},
+		"orcid":   &graphql.Field{Type: graphql.String},
+		"license": &graphql.Field{Type: graphql.String},
+		"created": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var quantumStateType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "QuantumState",
+	Fields: graphql.Fields{
+		"circuitHash":      &graphql.Field{Type: graphql.String},
+		"entanglementId":   &graphql.Field{Type: graphql.String},
+		"measurementBasis": &graphql.Field{Type: graphql.String},
+		"decoherenceTime":  &graphql.Field{Type: graphql.String},
+	},
+})
+
+// NewSchema builds the GraphQL schema resolved against ds.
+func NewSchema(ds DataSource) (graphql.Schema, error) {
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"getStatus": &graphql.Field{
+				Type: statusType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return ds.GetStatus(), nil
+				},
+			},
+			"getAIModel": &graphql.Field{
+				Type: aiModelType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := p.Args["id"].(string)
+					model, ok := ds.GetAIModel(id)
+					if !ok {
+						return nil, nil
+					}
+					return model, nil
+				},
+			},
+			"listAIModels": &graphql.Field{
+				Type: graphql.NewList(aiModelType),
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					filter, _ := p.Args["filter"].(string)
+					return ds.ListAIModels(filter), nil
+				},
+			},
+			"getValidator": &graphql.Field{
+				Type: validatorType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					address, _ := p.Args["address"].(string)
+					validator, ok := ds.GetValidator(address)
+					if !ok {
+						return nil, nil
+					}
+					return validator, nil
+				},
+			},
+			"getCreator": &graphql.Field{
+				Type: creatorType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return ds.GetCreator(), nil
+				},
+			},
+			"getQuantumState": &graphql.Field{
+				Type: quantumStateType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					state, ok := ds.GetQuantumState()
+					if !ok {
+						return nil, nil
+					}
+					return state, nil
+				},
+			},
+			"getAccountBalance": &graphql.Field{
+				Type: graphql.Int,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					address, _ := p.Args["address"].(string)
+					balance, ok := ds.GetAccountBalance(address)
+					if !ok {
+						return nil, nil
+					}
+					return balance, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
@@ -0,0 +1,64 @@
+package gql
+
+import (
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/handler"
+)
+
+// DefaultPort is the port the GraphQL server listens on unless overridden.
+const DefaultPort = "9473"
+
+// Server serves the PoAI GraphQL schema over HTTP, with an optional
+// playground and a websocket endpoint for the `blocks` subscription.
+type Server struct {
+	schema     graphql.Schema
+	playground bool
+	blocks     *blockBroadcaster
+}
+
+// NewServer builds a Server resolving queries against ds. Block events
+// passed to Publish are fanned out to every `blocks` subscriber; callers
+// should call Publish for the server's lifetime (e.g. once per Commit).
+func NewServer(ds DataSource, playground bool) (*Server, error) {
+	schema, err := NewSchema(ds)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		schema:     schema,
+		playground: playground,
+		blocks:     newBlockBroadcaster(),
+	}, nil
+}
+
+// Publish fans a new block event out to every active `blocks` subscriber.
+func (s *Server) Publish(evt BlockEvent) {
+	s.blocks.publish(evt)
+}
+
+// Handler returns the HTTP mux serving GraphQL queries at "/graphql", the
+// playground at "/" (if enabled), and the blocks subscription websocket at
+// "/subscriptions".
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	gqlHandler := handler.New(&handler.Config{
+		Schema:   &s.schema,
+		Pretty:   true,
+		GraphiQL: s.playground,
+	})
+	mux.Handle("/graphql", gqlHandler)
+	if s.playground {
+		mux.Handle("/", gqlHandler)
+	}
+	mux.HandleFunc("/subscriptions", s.serveSubscription)
+
+	return mux
+}
+
+// ListenAndServe starts the GraphQL server on addr (blocking).
+func (s *Server) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, s.Handler())
+}
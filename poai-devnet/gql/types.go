@@ -0,0 +1,46 @@
+// Package gql exposes PoAI chain state over GraphQL, mirroring the schema
+// style used by laconicd's nameservice module: a handful of flat query
+// fields backed by the same application state the ABCI app already uses, plus a
+// `blocks` subscription for new-block events.
+package gql
+
+import (
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/aimodel"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/poai"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/quantum"
+)
+
+// Status is the answer to getStatus: a snapshot of chain height/app hash.
+type Status struct {
+	Height  int64  `json:"height"`
+	AppHash string `json:"appHash"`
+}
+
+// Creator is the answer to getCreator.
+type Creator struct {
+	Name    string `json:"name"`
+	UID     string `json:"uid"`
+	ORCID   string `json:"orcid"`
+	License string `json:"license"`
+	Created string `json:"created"`
+}
+
+// DataSource is the read-only view of chain state the GraphQL server
+// resolves queries against. *app.PoAIApplication satisfies it via small
+// adapter methods, so this package never imports package main.
+type DataSource interface {
+	GetStatus() Status
+	GetAIModel(id string) (aimodel.Model, bool)
+	ListAIModels(modelType string) []aimodel.Model
+	GetValidator(address string) (poai.Validator, bool)
+	GetCreator() Creator
+	GetQuantumState() (quantum.State, bool)
+	GetAccountBalance(address string) (int64, bool)
+}
+
+// BlockEvent is published on every Commit and fanned out to subscribers of
+// the `blocks` GraphQL subscription.
+type BlockEvent struct {
+	Height  int64  `json:"height"`
+	AppHash string `json:"appHash"`
+}
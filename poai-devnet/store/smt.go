@@ -0,0 +1,204 @@
+// Package store provides an authenticated key/value store for PoAI chain
+// state, backed by a Sparse Merkle Tree (SMT) so validator and AI model
+// records can be proven to light clients without trusting a full node.
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+)
+
+const (
+	// keySize is the width of the hashed key space in bytes (sha256 output).
+	keySize = 32
+	// treeDepth is the number of levels in the tree, one per bit of the
+	// hashed key. Depth 256 keeps proofs a fixed, small size regardless of
+	// how many keys are actually populated.
+	treeDepth = keySize * 8
+)
+
+// ErrKeyNotFound is returned by Get when a key has no entry.
+var ErrKeyNotFound = errors.New("store: key not found")
+
+// zeroHashes[d] is the root hash of an empty subtree rooted at depth d.
+// zeroHashes[treeDepth] is the hash of an empty leaf; zeroHashes[0] is the
+// root hash of a completely empty tree.
+var zeroHashes [treeDepth + 1][]byte
+
+func init() {
+	zeroHashes[treeDepth] = make([]byte, keySize)
+	for d := treeDepth - 1; d >= 0; d-- {
+		zeroHashes[d] = innerHash(zeroHashes[d+1], zeroHashes[d+1])
+	}
+}
+
+func leafHash(key, value []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x00})
+	h.Write(key)
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x01})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// bitAt reports whether bit i (0 = most significant) of path is set.
+func bitAt(path []byte, i int) bool {
+	return path[i/8]&(1<<uint(7-i%8)) != 0
+}
+
+func hashKey(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
+
+// node is a persisted inner node, content-addressed by its own hash.
+type node struct {
+	Left, Right []byte
+}
+
+// SparseMerkleProof is an inclusion or exclusion proof for a single key: one
+// sibling hash per level, ordered from the root down to the leaf.
+type SparseMerkleProof struct {
+	SideNodes [][]byte
+}
+
+// SparseMerkleTree is a depth-256 Merkle tree keyed by sha256(key). Only
+// inner nodes actually written are kept in memory; untouched subtrees are
+// implicitly the precomputed zero hashes. Leaf values are kept in a plain
+// map, since the tree itself only needs to authenticate them, not store
+// them efficiently.
+type SparseMerkleTree struct {
+	nodes  map[string]*node
+	leaves map[string][]byte // hashKey(key) -> value
+	root   []byte
+}
+
+// NewSparseMerkleTree returns an empty SMT.
+func NewSparseMerkleTree() *SparseMerkleTree {
+	return &SparseMerkleTree{
+		nodes:  make(map[string]*node),
+		leaves: make(map[string][]byte),
+		root:   zeroHashes[0],
+	}
+}
+
+// Root returns the current root hash of the tree.
+func (t *SparseMerkleTree) Root() []byte {
+	return t.root
+}
+
+// Get returns the value stored for key, or ErrKeyNotFound.
+func (t *SparseMerkleTree) Get(key string) ([]byte, error) {
+	value, ok := t.leaves[string(hashKey(key))]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+// sideNodes walks the tree from the root to depth 256 along path, returning
+// the sibling hash at every level. Once it reaches an empty subtree, the
+// remaining levels are filled in directly from the zero hash table rather
+// than being walked, since they're guaranteed to be untouched.
+func (t *SparseMerkleTree) sideNodes(path []byte) [][]byte {
+	siblings := make([][]byte, treeDepth)
+	cur := t.root
+	for d := 0; d < treeDepth; d++ {
+		n := t.nodes[string(cur)]
+		if n == nil {
+			for dd := d; dd < treeDepth; dd++ {
+				siblings[dd] = zeroHashes[dd+1]
+			}
+			return siblings
+		}
+		if bitAt(path, d) {
+			siblings[d] = n.Left
+			cur = n.Right
+		} else {
+			siblings[d] = n.Right
+			cur = n.Left
+		}
+	}
+	return siblings
+}
+
+// fold rebuilds the path from a depth-256 leaf hash back up to the root,
+// persisting every inner node it creates along the way, and returns the
+// new root.
+func (t *SparseMerkleTree) fold(path []byte, siblings [][]byte, leaf []byte) []byte {
+	cur := leaf
+	for d := treeDepth - 1; d >= 0; d-- {
+		var n *node
+		if bitAt(path, d) {
+			n = &node{Left: siblings[d], Right: cur}
+		} else {
+			n = &node{Left: cur, Right: siblings[d]}
+		}
+		cur = innerHash(n.Left, n.Right)
+		t.nodes[string(cur)] = n
+	}
+	return cur
+}
+
+// Set inserts or updates the value for key and returns the new root.
+func (t *SparseMerkleTree) Set(key string, value []byte) []byte {
+	path := hashKey(key)
+	siblings := t.sideNodes(path)
+	t.root = t.fold(path, siblings, leafHash(path, value))
+	t.leaves[string(path)] = value
+	return t.root
+}
+
+// Delete removes key from the tree and returns the new root. Deleting a
+// key that is not present is a no-op.
+func (t *SparseMerkleTree) Delete(key string) []byte {
+	path := hashKey(key)
+	if _, ok := t.leaves[string(path)]; !ok {
+		return t.root
+	}
+	siblings := t.sideNodes(path)
+	t.root = t.fold(path, siblings, zeroHashes[treeDepth])
+	delete(t.leaves, string(path))
+	return t.root
+}
+
+// Prove returns a SparseMerkleProof for key, valid whether or not the key
+// is currently present (an absent key yields an exclusion proof).
+func (t *SparseMerkleTree) Prove(key string) (SparseMerkleProof, error) {
+	path := hashKey(key)
+	return SparseMerkleProof{SideNodes: t.sideNodes(path)}, nil
+}
+
+// VerifyProof checks that key/value is consistent with root under proof.
+// Passing a nil value verifies an exclusion proof instead.
+func VerifyProof(root []byte, key string, value []byte, proof SparseMerkleProof) bool {
+	if len(proof.SideNodes) != treeDepth {
+		return false
+	}
+	path := hashKey(key)
+
+	var cur []byte
+	if value == nil {
+		cur = zeroHashes[treeDepth]
+	} else {
+		cur = leafHash(path, value)
+	}
+
+	for d := treeDepth - 1; d >= 0; d-- {
+		side := proof.SideNodes[d]
+		if bitAt(path, d) {
+			cur = innerHash(side, cur)
+		} else {
+			cur = innerHash(cur, side)
+		}
+	}
+	return bytes.Equal(cur, root)
+}
@@ -0,0 +1,101 @@
+package store
+
+import "testing"
+
+func TestSetGet(t *testing.T) {
+	tree := NewSparseMerkleTree()
+
+	tree.Set("a", []byte("1"))
+	tree.Set("b", []byte("2"))
+
+	got, err := tree.Get("a")
+	if err != nil {
+		t.Fatalf("Get(a): %v", err)
+	}
+	if string(got) != "1" {
+		t.Errorf("Get(a) = %q, want %q", got, "1")
+	}
+
+	got, err = tree.Get("b")
+	if err != nil {
+		t.Fatalf("Get(b): %v", err)
+	}
+	if string(got) != "2" {
+		t.Errorf("Get(b) = %q, want %q", got, "2")
+	}
+}
+
+func TestGetMissingKey(t *testing.T) {
+	tree := NewSparseMerkleTree()
+	if _, err := tree.Get("missing"); err != ErrKeyNotFound {
+		t.Errorf("Get(missing) error = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestSetChangesRoot(t *testing.T) {
+	tree := NewSparseMerkleTree()
+	empty := tree.Root()
+
+	root := tree.Set("a", []byte("1"))
+	if string(root) == string(empty) {
+		t.Error("Set did not change the root")
+	}
+	if string(tree.Root()) != string(root) {
+		t.Error("Root() does not reflect the value Set returned")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tree := NewSparseMerkleTree()
+	empty := tree.Root()
+
+	tree.Set("a", []byte("1"))
+	root := tree.Delete("a")
+
+	if string(root) != string(empty) {
+		t.Error("deleting the only key did not restore the empty root")
+	}
+	if _, err := tree.Get("a"); err != ErrKeyNotFound {
+		t.Errorf("Get(a) after Delete error = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestDeleteMissingKeyIsNoop(t *testing.T) {
+	tree := NewSparseMerkleTree()
+	tree.Set("a", []byte("1"))
+	root := tree.Root()
+
+	if got := tree.Delete("missing"); string(got) != string(root) {
+		t.Error("deleting an absent key changed the root")
+	}
+}
+
+func TestProveAndVerifyInclusion(t *testing.T) {
+	tree := NewSparseMerkleTree()
+	tree.Set("a", []byte("1"))
+	tree.Set("b", []byte("2"))
+
+	proof, err := tree.Prove("a")
+	if err != nil {
+		t.Fatalf("Prove(a): %v", err)
+	}
+	if !VerifyProof(tree.Root(), "a", []byte("1"), proof) {
+		t.Error("VerifyProof rejected a valid inclusion proof")
+	}
+	if VerifyProof(tree.Root(), "a", []byte("wrong-value"), proof) {
+		t.Error("VerifyProof accepted an inclusion proof against the wrong value")
+	}
+}
+
+func TestProveAndVerifyExclusion(t *testing.T) {
+	tree := NewSparseMerkleTree()
+	tree.Set("a", []byte("1"))
+
+	proof, err := tree.Prove("never-set")
+	if err != nil {
+		t.Fatalf("Prove(never-set): %v", err)
+	}
+	if !VerifyProof(tree.Root(), "never-set", nil, proof) {
+		t.Error("VerifyProof rejected a valid exclusion proof")
+	}
+}
@@ -0,0 +1,46 @@
+// Package types holds the shared Msg/Handler/Router contracts and the
+// codespaced error type used across the x/poai, x/aimodel, and x/quantum
+// modules, mirroring the Cosmos SDK's pre-typed-errors sdk.Error pattern.
+package types
+
+import "fmt"
+
+// Codespaces namespace error codes by module so the same numeric code can
+// be reused across modules without colliding.
+const (
+	CodespacePoAI    = "poai"
+	CodespaceAIModel = "aimodel"
+	CodespaceQuantum = "quantum"
+)
+
+// CodeType is a codespace-scoped numeric error code.
+type CodeType uint32
+
+const (
+	CodeInvalidValidator CodeType = 101
+	CodeUnauthorized     CodeType = 102
+
+	CodeInvalidAIModel  CodeType = 201
+	CodeAIModelNotFound CodeType = 202
+
+	CodeInvalidQuantumCircuit     CodeType = 301
+	CodeQuantumVerificationFailed CodeType = 302
+)
+
+// Error is a codespaced application error: every error a module handler
+// returns carries both a codespace and a numeric code so callers (and
+// light clients) can branch on it without string matching on Desc.
+type Error struct {
+	Codespace string
+	Code      CodeType
+	Desc      string
+}
+
+// New builds a codespaced Error.
+func New(codespace string, code CodeType, desc string) *Error {
+	return &Error{Codespace: codespace, Code: code, Desc: desc}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s (code %d)", e.Codespace, e.Desc, e.Code)
+}
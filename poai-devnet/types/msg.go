@@ -0,0 +1,22 @@
+package types
+
+// Msg is the interface every PoAI transaction message implements, mirroring
+// the Cosmos SDK's sdk.Msg: a message knows which module route it belongs
+// to, how to describe itself, how to self-validate before it reaches a
+// handler, and who signed it.
+type Msg interface {
+	Route() string
+	Type() string
+	ValidateBasic() error
+	GetSigner() string
+}
+
+// Result is returned by a Handler on success, analogous to sdk.Result.
+type Result struct {
+	Log  string
+	Data []byte
+}
+
+// Handler processes a single Msg routed to a module, analogous to the
+// Cosmos SDK's sdk.Handler.
+type Handler func(msg Msg) (*Result, error)
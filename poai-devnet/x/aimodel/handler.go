@@ -0,0 +1,46 @@
+package aimodel
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// NewHandler returns the handler for all x/aimodel messages, to be
+// registered on the application Router under RouteAIModel.
+func NewHandler(k Keeper) types.Handler {
+	return func(msg types.Msg) (*types.Result, error) {
+		switch msg := msg.(type) {
+		case MsgRegisterAIModel:
+			return handleMsgRegisterAIModel(k, msg)
+		case MsgSubmitInference:
+			return handleMsgSubmitInference(k, msg)
+		default:
+			return nil, types.New(types.CodespaceAIModel, types.CodeInvalidAIModel,
+				fmt.Sprintf("unrecognized aimodel message type: %T", msg))
+		}
+	}
+}
+
+func handleMsgRegisterAIModel(k Keeper, msg MsgRegisterAIModel) (*types.Result, error) {
+	err := k.SetModel(Model{
+		ID:       msg.ID,
+		Type:     msg.ModelType,
+		Accuracy: msg.Accuracy,
+		LastUsed: time.Now(),
+		Creator:  msg.Signer,
+		Hash:     msg.Hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Result{Log: fmt.Sprintf("registered AI model %q", msg.ID)}, nil
+}
+
+func handleMsgSubmitInference(k Keeper, msg MsgSubmitInference) (*types.Result, error) {
+	if err := k.RecordInference(msg.ModelID, msg.Score, msg.TxHash); err != nil {
+		return nil, err
+	}
+	return &types.Result{Log: fmt.Sprintf("recorded inference for model %q", msg.ModelID)}, nil
+}
@@ -0,0 +1,80 @@
+// Package aimodel is the Cosmos SDK-style module that owns AI validation
+// model registrations and inference submissions.
+package aimodel
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/store"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// Model represents an AI validation model.
+type Model struct {
+	ID       string    `json:"id"`
+	Type     string    `json:"type"`
+	Accuracy float64   `json:"accuracy"`
+	LastUsed time.Time `json:"last_used"`
+	Creator  string    `json:"creator"`
+	Hash     string    `json:"hash"`
+}
+
+// Inference is the most recent inference result recorded against a model.
+type Inference struct {
+	Score  float64 `json:"score"`
+	TxHash string  `json:"tx_hash"`
+}
+
+// Keeper persists AI models and the latest inference recorded against
+// each in the shared SMT-backed store, under the "aimodel/" key prefix.
+type Keeper struct {
+	store *store.SparseMerkleTree
+}
+
+// NewKeeper returns a Keeper backed by st.
+func NewKeeper(st *store.SparseMerkleTree) Keeper {
+	return Keeper{store: st}
+}
+
+func modelKey(id string) string     { return "aimodel/" + id }
+func inferenceKey(id string) string { return "aimodel/" + id + "/last_inference" }
+
+// GetModel returns the model registered under id, if any.
+func (k Keeper) GetModel(id string) (Model, bool) {
+	data, err := k.store.Get(modelKey(id))
+	if err != nil {
+		return Model{}, false
+	}
+	var m Model
+	if err := json.Unmarshal(data, &m); err != nil {
+		return Model{}, false
+	}
+	return m, true
+}
+
+// SetModel registers or updates a model.
+func (k Keeper) SetModel(m Model) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	k.store.Set(modelKey(m.ID), data)
+	return nil
+}
+
+// RecordInference stores the latest inference score for an already
+// registered model, so validators can reference agreement history.
+func (k Keeper) RecordInference(modelID string, score float64, txHash string) error {
+	if _, ok := k.GetModel(modelID); !ok {
+		return types.New(types.CodespaceAIModel, types.CodeAIModelNotFound,
+			fmt.Sprintf("model %q is not registered", modelID))
+	}
+	data, err := json.Marshal(Inference{Score: score, TxHash: txHash})
+	if err != nil {
+		return err
+	}
+	k.store.Set(inferenceKey(modelID), data)
+	return nil
+}
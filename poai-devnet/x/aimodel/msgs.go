@@ -0,0 +1,63 @@
+package aimodel
+
+import "github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+
+// RouteAIModel is the Router key this module's handler is registered
+// under.
+const RouteAIModel = "aimodel"
+
+// MsgRegisterAIModel registers a new AI validation model.
+type MsgRegisterAIModel struct {
+	Signer    string  `json:"signer"`
+	ID        string  `json:"id"`
+	ModelType string  `json:"model_type"`
+	Accuracy  float64 `json:"accuracy"`
+	Hash      string  `json:"hash"`
+}
+
+func (msg MsgRegisterAIModel) Route() string     { return RouteAIModel }
+func (msg MsgRegisterAIModel) Type() string      { return "register_ai_model" }
+func (msg MsgRegisterAIModel) GetSigner() string { return msg.Signer }
+
+// ValidateBasic runs stateless sanity checks before the message reaches a
+// handler.
+func (msg MsgRegisterAIModel) ValidateBasic() error {
+	if msg.Signer == "" {
+		return types.New(types.CodespaceAIModel, types.CodeUnauthorized, "signer cannot be empty")
+	}
+	if msg.ID == "" {
+		return types.New(types.CodespaceAIModel, types.CodeInvalidAIModel, "model id cannot be empty")
+	}
+	if msg.Accuracy < 0 || msg.Accuracy > 1 {
+		return types.New(types.CodespaceAIModel, types.CodeInvalidAIModel, "accuracy must be between 0 and 1")
+	}
+	return nil
+}
+
+// MsgSubmitInference records an inference result against a registered
+// model.
+type MsgSubmitInference struct {
+	Signer  string  `json:"signer"`
+	ModelID string  `json:"model_id"`
+	TxHash  string  `json:"tx_hash"`
+	Score   float64 `json:"score"`
+}
+
+func (msg MsgSubmitInference) Route() string     { return RouteAIModel }
+func (msg MsgSubmitInference) Type() string      { return "submit_inference" }
+func (msg MsgSubmitInference) GetSigner() string { return msg.Signer }
+
+// ValidateBasic runs stateless sanity checks before the message reaches a
+// handler.
+func (msg MsgSubmitInference) ValidateBasic() error {
+	if msg.Signer == "" {
+		return types.New(types.CodespaceAIModel, types.CodeUnauthorized, "signer cannot be empty")
+	}
+	if msg.ModelID == "" {
+		return types.New(types.CodespaceAIModel, types.CodeInvalidAIModel, "model_id cannot be empty")
+	}
+	if msg.Score < 0 || msg.Score > 1 {
+		return types.New(types.CodespaceAIModel, types.CodeInvalidAIModel, "score must be between 0 and 1")
+	}
+	return nil
+}
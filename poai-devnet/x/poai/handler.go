@@ -0,0 +1,38 @@
+package poai
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// NewHandler returns the handler for all x/poai messages, to be registered
+// on the application Router under RoutePoAI.
+func NewHandler(k Keeper) types.Handler {
+	return func(msg types.Msg) (*types.Result, error) {
+		switch msg := msg.(type) {
+		case MsgUpdateValidatorAIScore:
+			return handleMsgUpdateValidatorAIScore(k, msg)
+		default:
+			return nil, types.New(types.CodespacePoAI, types.CodeInvalidValidator,
+				fmt.Sprintf("unrecognized poai message type: %T", msg))
+		}
+	}
+}
+
+func handleMsgUpdateValidatorAIScore(k Keeper, msg MsgUpdateValidatorAIScore) (*types.Result, error) {
+	sig, err := hex.DecodeString(msg.Signature)
+	if err != nil {
+		return nil, types.New(types.CodespacePoAI, types.CodeUnauthorized, "signature is not valid hex")
+	}
+	pubKey := k.AuthorityPubKey()
+	if len(pubKey) == 0 || !pubKey.VerifySignature(msg.SignBytes(), sig) {
+		return nil, types.New(types.CodespacePoAI, types.CodeUnauthorized,
+			fmt.Sprintf("signer %q did not present a valid AI-score-authority signature", msg.Signer))
+	}
+	if err := k.UpdateAIScore(msg.Address, msg.AIScore); err != nil {
+		return nil, err
+	}
+	return &types.Result{Log: fmt.Sprintf("updated AI score for validator %q", msg.Address)}, nil
+}
@@ -0,0 +1,86 @@
+// Package poai is the Cosmos SDK-style module that owns validator records
+// and their AI-derived power weighting.
+package poai
+
+import (
+	"encoding/json"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/store"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// Validator represents a network validator. BasePower is the power
+// assigned at InitChain (or by governance); Power is BasePower rescaled
+// by AIScore at each epoch boundary, so BasePower must be kept around to
+// rescale from rather than compounding adjustments onto Power itself.
+type Validator struct {
+	Address   string  `json:"address"`
+	PubKey    string  `json:"pubkey"`
+	Power     int64   `json:"power"`
+	BasePower int64   `json:"base_power"`
+	AIScore   float64 `json:"ai_score"`
+}
+
+// Keeper persists validator records in the shared SMT-backed store, under
+// the "validator/" key prefix. authorityPubKey is the ed25519 public key
+// MsgUpdateValidatorAIScore.Signature must verify against; AIScore feeds
+// directly into EndBlock's consensus power math, so updating it is gated
+// by a real signature check the same way a Cosmos SDK module would gate
+// a governance- or oracle-only message, rather than trusting an
+// unauthenticated Signer field. A nil/empty authorityPubKey rejects every
+// update, which is the safe default for a devnet that hasn't been
+// configured with a real oracle key yet.
+type Keeper struct {
+	store           *store.SparseMerkleTree
+	authorityPubKey ed25519.PubKey
+}
+
+// NewKeeper returns a Keeper backed by st, accepting AI score updates
+// only when signed by authorityPubKey.
+func NewKeeper(st *store.SparseMerkleTree, authorityPubKey ed25519.PubKey) Keeper {
+	return Keeper{store: st, authorityPubKey: authorityPubKey}
+}
+
+// AuthorityPubKey returns the public key AI score update signatures must
+// verify against.
+func (k Keeper) AuthorityPubKey() ed25519.PubKey {
+	return k.authorityPubKey
+}
+
+func validatorKey(addr string) string { return "validator/" + addr }
+
+// GetValidator returns the validator record for addr, if any.
+func (k Keeper) GetValidator(addr string) (Validator, bool) {
+	data, err := k.store.Get(validatorKey(addr))
+	if err != nil {
+		return Validator{}, false
+	}
+	var v Validator
+	if err := json.Unmarshal(data, &v); err != nil {
+		return Validator{}, false
+	}
+	return v, true
+}
+
+// SetValidator persists a validator record.
+func (k Keeper) SetValidator(v Validator) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	k.store.Set(validatorKey(v.Address), data)
+	return nil
+}
+
+// UpdateAIScore overwrites the AI agreement score for an existing
+// validator.
+func (k Keeper) UpdateAIScore(addr string, score float64) error {
+	v, ok := k.GetValidator(addr)
+	if !ok {
+		return types.New(types.CodespacePoAI, types.CodeInvalidValidator, "validator "+addr+" not found")
+	}
+	v.AIScore = score
+	return k.SetValidator(v)
+}
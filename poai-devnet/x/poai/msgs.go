@@ -0,0 +1,51 @@
+package poai
+
+import (
+	"fmt"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// RoutePoAI is the Router key this module's handler is registered under.
+const RoutePoAI = "poai"
+
+// MsgUpdateValidatorAIScore updates a validator's AI agreement score,
+// which EndBlock uses to re-weight validator power each epoch. Signature
+// is a hex-encoded ed25519 signature over SignBytes(), produced by the
+// AI-score authority's private key; Signer is left as a human-readable
+// label only, since it isn't authenticated on its own.
+type MsgUpdateValidatorAIScore struct {
+	Signer    string  `json:"signer"`
+	Address   string  `json:"address"`
+	AIScore   float64 `json:"ai_score"`
+	Signature string  `json:"signature"`
+}
+
+func (msg MsgUpdateValidatorAIScore) Route() string     { return RoutePoAI }
+func (msg MsgUpdateValidatorAIScore) Type() string      { return "update_validator_ai_score" }
+func (msg MsgUpdateValidatorAIScore) GetSigner() string { return msg.Signer }
+
+// SignBytes returns the canonical bytes the AI-score authority signs:
+// every field but Signature itself, so a signature can't be replayed
+// against a different address or score.
+func (msg MsgUpdateValidatorAIScore) SignBytes() []byte {
+	return []byte(fmt.Sprintf("poai/UpdateValidatorAIScore:%s:%s:%.17g", msg.Signer, msg.Address, msg.AIScore))
+}
+
+// ValidateBasic runs stateless sanity checks before the message reaches a
+// handler.
+func (msg MsgUpdateValidatorAIScore) ValidateBasic() error {
+	if msg.Signer == "" {
+		return types.New(types.CodespacePoAI, types.CodeUnauthorized, "signer cannot be empty")
+	}
+	if msg.Address == "" {
+		return types.New(types.CodespacePoAI, types.CodeInvalidValidator, "address cannot be empty")
+	}
+	if msg.AIScore < 0 {
+		return types.New(types.CodespacePoAI, types.CodeInvalidValidator, "ai_score cannot be negative")
+	}
+	if msg.Signature == "" {
+		return types.New(types.CodespacePoAI, types.CodeUnauthorized, "signature cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package poaiwitness
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the x/poaiwitness CLI transaction commands, to be
+// wired into `poaid tx` alongside every other module's GetTxCmd.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "poaiwitness",
+		Short:                      "poaiwitness transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+	cmd.AddCommand(NewRequestWitnessCmd())
+	return cmd
+}
+
+// requestWitnessEnvelope mirrors app.txEnvelope's "poaiwitness/RequestWitness"
+// case: PoAI's ABCI app decodes raw JSON tx bytes shaped exactly like
+// this, not a signed protobuf transaction, since it predates this chain
+// running a full cosmos-sdk BaseApp.
+type requestWitnessEnvelope struct {
+	Type  string            `json:"type"`
+	Value MsgRequestWitness `json:"value"`
+}
+
+// NewRequestWitnessCmd builds `poaid tx poaiwitness request`, which submits
+// a MsgRequestWitness to the PoAI chain over CometBFT's broadcast_tx_sync
+// RPC, running it through the same AI/quantum validation OnRecvPacket
+// would run an IBC-relayed request through.
+func NewRequestWitnessCmd() *cobra.Command {
+	var node, signer, txHash, modelID string
+	var requestedConfidence float64
+
+	cmd := &cobra.Command{
+		Use:   "request",
+		Short: "Request an AI/quantum validation witness from the PoAI chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := MsgRequestWitness{
+				Signer: signer,
+				Request: PoAIWitnessRequest{
+					TxHash:              txHash,
+					ModelID:             modelID,
+					RequestedConfidence: requestedConfidence,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			tx, err := json.Marshal(requestWitnessEnvelope{Type: "poaiwitness/RequestWitness", Value: msg})
+			if err != nil {
+				return fmt.Errorf("poaiwitness: encoding request: %w", err)
+			}
+
+			client, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return fmt.Errorf("poaiwitness: connecting to %q: %w", node, err)
+			}
+
+			result, err := client.BroadcastTxSync(cmd.Context(), tx)
+			if err != nil {
+				return fmt.Errorf("poaiwitness: broadcasting request: %w", err)
+			}
+			if result.Code != 0 {
+				return fmt.Errorf("poaiwitness: request rejected: %s", result.Log)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "witness requested: tx_hash=%s log=%s\n", result.Hash, result.Log)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&node, "node", "tcp://localhost:26657", "CometBFT RPC address of the PoAI node to submit the request to")
+	cmd.Flags().StringVar(&signer, "signer", "", "Address submitting the request")
+	cmd.Flags().StringVar(&txHash, "tx-hash", "", "Hash of the transaction to request a witness for")
+	cmd.Flags().StringVar(&modelID, "model-id", "", "AI model to validate the transaction against")
+	cmd.Flags().Float64Var(&requestedConfidence, "requested-confidence", 0.9, "Minimum AI confidence required of the witness")
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package poaiwitness
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// NewHandler returns the handler for all x/poaiwitness messages, to be
+// registered on the application Router under RoutePoAIWitness. It is the
+// devnet-local counterpart to IBCModule.OnRecvPacket: the same
+// Keeper.Validate call, reached directly by this chain's own tx path
+// instead of by a relayed IBC packet from a counterparty chain.
+func NewHandler(k Keeper) types.Handler {
+	return func(msg types.Msg) (*types.Result, error) {
+		switch msg := msg.(type) {
+		case MsgRequestWitness:
+			return handleMsgRequestWitness(k, msg)
+		default:
+			return nil, fmt.Errorf("poaiwitness: unrecognized message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgRequestWitness(k Keeper, msg MsgRequestWitness) (*types.Result, error) {
+	resp, err := k.Validate(msg.Request)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Result{
+		Log:  fmt.Sprintf("witnessed tx %q with AI score %.4f", msg.Request.TxHash, resp.AIScore),
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package poaiwitness
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// IBCModule implements porttypes.IBCModule for x/poaiwitness: it checks an
+// incoming PoAIWitnessRequest with Keeper.Validate (model accuracy and
+// committed quantum state; not the aivalidator scoring CheckTx uses), and
+// returns the result as a PoAIWitnessResponse in the packet acknowledgement.
+type IBCModule struct {
+	keeper Keeper
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// NewIBCModule returns an IBCModule backed by k.
+func NewIBCModule(k Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+// OnChanOpenInit validates the channel parameters this chain is opening,
+// rejecting anything that isn't an unordered channel on the expected
+// version.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", fmt.Errorf("poaiwitness: channel must be UNORDERED")
+	}
+	if version != "" && version != Version {
+		return "", fmt.Errorf("poaiwitness: unsupported version %q, expected %q", version, Version)
+	}
+	return Version, nil
+}
+
+// OnChanOpenTry mirrors OnChanOpenInit's checks against the counterparty's
+// proposed version, since this side didn't initiate the handshake.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", fmt.Errorf("poaiwitness: channel must be UNORDERED")
+	}
+	if counterpartyVersion != Version {
+		return "", fmt.Errorf("poaiwitness: unsupported counterparty version %q, expected %q", counterpartyVersion, Version)
+	}
+	return Version, nil
+}
+
+// OnChanOpenAck confirms the counterparty agreed to our version.
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	if counterpartyVersion != Version {
+		return fmt.Errorf("poaiwitness: unsupported counterparty version %q, expected %q", counterpartyVersion, Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm completes the four-step handshake; there is no
+// module-specific state to initialize.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit allows either side to close the channel; witness
+// requests have no in-flight state that needs draining first.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseConfirm acknowledges the counterparty closed its end.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket decodes an incoming PoAIWitnessRequest, runs it through the
+// keeper's AI/quantum validation, and returns the result wrapped in the
+// standard ibc-go Acknowledgement envelope so a failed request still
+// produces a deliverable (but Success()==false) acknowledgement rather
+// than aborting the packet.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var req PoAIWitnessRequest
+	if err := json.Unmarshal(packet.GetData(), &req); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("poaiwitness: invalid packet data: %w", err))
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	resp, err := im.keeper.Validate(req)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	ackBytes, err := NewResultAcknowledgement(resp).GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(ackBytes)
+}
+
+// OnAcknowledgementPacket is invoked on the requesting chain once the
+// witness response comes back; there is no local state to update, so this
+// is a no-op beyond validating the envelope decodes.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return fmt.Errorf("poaiwitness: cannot unmarshal acknowledgement: %w", err)
+	}
+	return nil
+}
+
+// OnTimeoutPacket is invoked if a witness request goes unanswered within
+// the packet's timeout window; there is no escrowed state to refund, so
+// this is a no-op.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return nil
+}
@@ -0,0 +1,73 @@
+package poaiwitness
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/aimodel"
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/x/quantum"
+)
+
+// Keeper answers witness requests from the requested model's registered
+// accuracy and the chain's committed quantum state. It does not run the
+// transaction through the aivalidator backends CheckTx/DeliverTx score
+// with; a request carries only the tx hash. signingKey signs every response
+// returned, so a requester who has this node's public key (PubKey) can
+// verify the witness actually came from it.
+type Keeper struct {
+	aimodelKeeper aimodel.Keeper
+	quantumKeeper quantum.Keeper
+	height        func() int64
+	signingKey    ed25519.PrivKey
+}
+
+// NewKeeper returns a Keeper that reads AI models from aik, quantum state
+// from qk, the current chain height from height, and signs responses
+// with signingKey.
+func NewKeeper(aik aimodel.Keeper, qk quantum.Keeper, height func() int64, signingKey ed25519.PrivKey) Keeper {
+	return Keeper{aimodelKeeper: aik, quantumKeeper: qk, height: height, signingKey: signingKey}
+}
+
+// PubKey returns the public key a requester verifies a response's
+// Signature against.
+func (k Keeper) PubKey() ed25519.PubKey {
+	return k.signingKey.PubKey().(ed25519.PubKey)
+}
+
+// Validate runs req against the named model's last recorded accuracy and
+// the chain's current quantum state, returning a response whose AIScore
+// meets or exceeds req.RequestedConfidence only if the model actually
+// supports it.
+func (k Keeper) Validate(req PoAIWitnessRequest) (PoAIWitnessResponse, error) {
+	model, ok := k.aimodelKeeper.GetModel(req.ModelID)
+	if !ok {
+		return PoAIWitnessResponse{}, fmt.Errorf("poaiwitness: model %q is not registered", req.ModelID)
+	}
+
+	state, ok := k.quantumKeeper.GetState()
+	if !ok || state.CircuitHash == "" {
+		return PoAIWitnessResponse{}, fmt.Errorf("poaiwitness: no quantum state committed yet")
+	}
+
+	if model.Accuracy < req.RequestedConfidence {
+		return PoAIWitnessResponse{}, fmt.Errorf(
+			"poaiwitness: model %q accuracy %.4f below requested confidence %.4f",
+			req.ModelID, model.Accuracy, req.RequestedConfidence)
+	}
+
+	sum := sha256.Sum256([]byte(req.TxHash + state.CircuitHash))
+	resp := PoAIWitnessResponse{
+		AIScore:          model.Accuracy,
+		QuantumProofHash: fmt.Sprintf("%x", sum),
+		Height:           k.height(),
+	}
+
+	sig, err := k.signingKey.Sign(resp.SignBytes())
+	if err != nil {
+		return PoAIWitnessResponse{}, fmt.Errorf("poaiwitness: signing response: %w", err)
+	}
+	resp.Signature = fmt.Sprintf("%x", sig)
+	return resp, nil
+}
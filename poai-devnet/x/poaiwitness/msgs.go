@@ -0,0 +1,30 @@
+package poaiwitness
+
+import "fmt"
+
+// RoutePoAIWitness is the Router key this module's handler is registered
+// under, for requesters that are clients of this chain directly rather
+// than a counterparty chain relaying a packet in over IBC.
+const RoutePoAIWitness = "poaiwitness"
+
+// MsgRequestWitness asks this chain to run req through its own AI/quantum
+// validation pipeline and return a PoAIWitnessResponse, the same thing
+// OnRecvPacket does for a counterparty chain's IBC packet, but reachable
+// directly through this chain's own transaction path.
+type MsgRequestWitness struct {
+	Signer  string             `json:"signer"`
+	Request PoAIWitnessRequest `json:"request"`
+}
+
+func (msg MsgRequestWitness) Route() string     { return RoutePoAIWitness }
+func (msg MsgRequestWitness) Type() string      { return "request_witness" }
+func (msg MsgRequestWitness) GetSigner() string { return msg.Signer }
+
+// ValidateBasic runs stateless sanity checks before the message reaches a
+// handler.
+func (msg MsgRequestWitness) ValidateBasic() error {
+	if msg.Signer == "" {
+		return fmt.Errorf("poaiwitness: signer cannot be empty")
+	}
+	return msg.Request.ValidateBasic()
+}
@@ -0,0 +1,100 @@
+// Package poaiwitness is an IBC application module that lets other Cosmos
+// chains request an AI/quantum validation witness from the PoAI chain for
+// a transaction they've observed, without joining the PoAI network
+// themselves.
+package poaiwitness
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PortID is the default port this module binds, matching its module name
+// by convention.
+const PortID = "poaiwitness"
+
+// Version is the IBC application version negotiated during the channel
+// handshake.
+const Version = "poaiwitness-1"
+
+// PoAIWitnessRequest is the packet data a counterparty chain sends to ask
+// the PoAI chain to validate a transaction it has observed.
+type PoAIWitnessRequest struct {
+	TxHash              string  `json:"tx_hash"`
+	ModelID             string  `json:"model_id"`
+	RequestedConfidence float64 `json:"requested_confidence"`
+}
+
+// GetBytes returns the canonical JSON encoding of the packet data, used as
+// both the wire format and the preimage for any packet commitment hashing.
+func (p PoAIWitnessRequest) GetBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// ValidateBasic sanity-checks the request before it is sent or, on the
+// receiving end, before OnRecvPacket acts on it.
+func (p PoAIWitnessRequest) ValidateBasic() error {
+	if p.TxHash == "" {
+		return fmt.Errorf("poaiwitness: tx_hash cannot be empty")
+	}
+	if p.ModelID == "" {
+		return fmt.Errorf("poaiwitness: model_id cannot be empty")
+	}
+	if p.RequestedConfidence < 0 || p.RequestedConfidence > 1 {
+		return fmt.Errorf("poaiwitness: requested_confidence must be between 0 and 1")
+	}
+	return nil
+}
+
+// PoAIWitnessResponse is the packet data the PoAI chain returns: the
+// requested model's accuracy as AIScore and a hash binding the tx hash to
+// the committed quantum circuit, signed over by this node's witness signing key
+// (see Keeper.PubKey for the public key a requester verifies Signature
+// against).
+type PoAIWitnessResponse struct {
+	AIScore          float64 `json:"ai_score"`
+	QuantumProofHash string  `json:"quantum_proof_hash"`
+	Signature        string  `json:"signature"`
+	Height           int64   `json:"height"`
+}
+
+// SignBytes returns the canonical bytes this node's witness signing key
+// signs: every field but Signature itself, so a signature can't be
+// replayed onto a different response.
+func (r PoAIWitnessResponse) SignBytes() []byte {
+	return []byte(fmt.Sprintf("poaiwitness/Response:%.17g:%s:%d", r.AIScore, r.QuantumProofHash, r.Height))
+}
+
+// GetBytes returns the canonical JSON encoding of the response, carried
+// back to the requester inside the packet acknowledgement.
+func (r PoAIWitnessResponse) GetBytes() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// PacketAcknowledgement wraps either a successful PoAIWitnessResponse or
+// an error string, mirroring ibc-go's channeltypes.Acknowledgement.
+type PacketAcknowledgement struct {
+	Result *PoAIWitnessResponse `json:"result,omitempty"`
+	Error  string               `json:"error,omitempty"`
+}
+
+// NewResultAcknowledgement wraps a successful response.
+func NewResultAcknowledgement(resp PoAIWitnessResponse) PacketAcknowledgement {
+	return PacketAcknowledgement{Result: &resp}
+}
+
+// NewErrorAcknowledgement wraps a failed request.
+func NewErrorAcknowledgement(err error) PacketAcknowledgement {
+	return PacketAcknowledgement{Error: err.Error()}
+}
+
+// Success reports whether the acknowledgement carries a result rather
+// than an error.
+func (a PacketAcknowledgement) Success() bool {
+	return a.Result != nil
+}
+
+// GetBytes returns the canonical JSON encoding of the acknowledgement.
+func (a PacketAcknowledgement) GetBytes() ([]byte, error) {
+	return json.Marshal(a)
+}
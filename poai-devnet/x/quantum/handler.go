@@ -0,0 +1,34 @@
+package quantum
+
+import (
+	"fmt"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+)
+
+// NewHandler returns the handler for all x/quantum messages, to be
+// registered on the application Router under RouteQuantum.
+func NewHandler(k Keeper) types.Handler {
+	return func(msg types.Msg) (*types.Result, error) {
+		switch msg := msg.(type) {
+		case MsgCommitQuantumCircuit:
+			return handleMsgCommitQuantumCircuit(k, msg)
+		default:
+			return nil, types.New(types.CodespaceQuantum, types.CodeInvalidQuantumCircuit,
+				fmt.Sprintf("unrecognized quantum message type: %T", msg))
+		}
+	}
+}
+
+func handleMsgCommitQuantumCircuit(k Keeper, msg MsgCommitQuantumCircuit) (*types.Result, error) {
+	err := k.SetState(State{
+		CircuitHash:      msg.CircuitHash,
+		EntanglementID:   msg.EntanglementID,
+		MeasurementBasis: msg.MeasurementBasis,
+		DecoherenceTime:  msg.DecoherenceTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Result{Log: fmt.Sprintf("committed quantum circuit %q", msg.CircuitHash)}, nil
+}
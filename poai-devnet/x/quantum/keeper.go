@@ -0,0 +1,55 @@
+// Package quantum is the Cosmos SDK-style module that owns the chain's
+// quantum circuit verification state.
+package quantum
+
+import (
+	"encoding/json"
+
+	"github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/store"
+)
+
+// State maintains quantum verification state.
+type State struct {
+	CircuitHash      string `json:"circuit_hash"`
+	EntanglementID   string `json:"entanglement_id"`
+	MeasurementBasis string `json:"measurement_basis"`
+	DecoherenceTime  string `json:"decoherence_time"`
+}
+
+// stateKey is the sole key this module writes; unlike x/aimodel there is
+// one chain-wide quantum state rather than one record per ID.
+const stateKey = "quantum/state"
+
+// Keeper persists the chain's quantum state in the shared SMT-backed
+// store.
+type Keeper struct {
+	store *store.SparseMerkleTree
+}
+
+// NewKeeper returns a Keeper backed by st.
+func NewKeeper(st *store.SparseMerkleTree) Keeper {
+	return Keeper{store: st}
+}
+
+// GetState returns the current quantum state, if any has been committed.
+func (k Keeper) GetState() (State, bool) {
+	data, err := k.store.Get(stateKey)
+	if err != nil {
+		return State{}, false
+	}
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return State{}, false
+	}
+	return s, true
+}
+
+// SetState commits a new quantum state.
+func (k Keeper) SetState(s State) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	k.store.Set(stateKey, data)
+	return nil
+}
@@ -0,0 +1,33 @@
+package quantum
+
+import "github.com/OmegaT4224/Andrew-Lee-Cruz/poai-devnet/types"
+
+// RouteQuantum is the Router key this module's handler is registered
+// under.
+const RouteQuantum = "quantum"
+
+// MsgCommitQuantumCircuit commits a new quantum circuit verification
+// result to chain state.
+type MsgCommitQuantumCircuit struct {
+	Signer           string `json:"signer"`
+	CircuitHash      string `json:"circuit_hash"`
+	EntanglementID   string `json:"entanglement_id"`
+	MeasurementBasis string `json:"measurement_basis"`
+	DecoherenceTime  string `json:"decoherence_time"`
+}
+
+func (msg MsgCommitQuantumCircuit) Route() string     { return RouteQuantum }
+func (msg MsgCommitQuantumCircuit) Type() string      { return "commit_quantum_circuit" }
+func (msg MsgCommitQuantumCircuit) GetSigner() string { return msg.Signer }
+
+// ValidateBasic runs stateless sanity checks before the message reaches a
+// handler.
+func (msg MsgCommitQuantumCircuit) ValidateBasic() error {
+	if msg.Signer == "" {
+		return types.New(types.CodespaceQuantum, types.CodeUnauthorized, "signer cannot be empty")
+	}
+	if msg.CircuitHash == "" {
+		return types.New(types.CodespaceQuantum, types.CodeInvalidQuantumCircuit, "circuit_hash cannot be empty")
+	}
+	return nil
+}